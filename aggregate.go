@@ -0,0 +1,359 @@
+package null
+
+import (
+	"time"
+)
+
+// Count returns the number of non-null values, like SQL's COUNT(column).
+func Count[T interface{ IsZero() bool }](vals []T) int {
+	n := 0
+	for _, v := range vals {
+		if !v.IsZero() {
+			n++
+		}
+	}
+	return n
+}
+
+// Int64Accumulator computes SQL aggregates over a stream of Int64 values.
+// Null values are skipped, and every aggregate except Count is null if no valid value was added.
+// The zero value is ready to use.
+type Int64Accumulator struct {
+	count    int
+	sum      int64
+	fsum     float64
+	min, max int64
+	carry    int // number of times sum wrapped past MaxInt64, minus wraps past MinInt64
+}
+
+// Add adds v to the aggregates. It does nothing if v is null.
+func (a *Int64Accumulator) Add(v Int64) {
+	if !v.Valid {
+		return
+	}
+	n := v.Int64
+	if a.count == 0 || n < a.min {
+		a.min = n
+	}
+	if a.count == 0 || n > a.max {
+		a.max = n
+	}
+	sum := a.sum + n
+	switch {
+	case n > 0 && sum < a.sum:
+		a.carry++
+	case n < 0 && sum > a.sum:
+		a.carry--
+	}
+	a.sum = sum
+	a.fsum += float64(n)
+	a.count++
+}
+
+// Count returns the number of non-null values added.
+func (a *Int64Accumulator) Count() int {
+	return a.count
+}
+
+// Sum returns the sum of the values added.
+// It returns ErrOverflow if the sum does not fit in an int64.
+// Intermediate sums may overflow as long as the final sum fits.
+func (a *Int64Accumulator) Sum() (Int64, error) {
+	if a.carry != 0 {
+		return NewInt64(0, false), ErrOverflow
+	}
+	return NewInt64(a.sum, a.count > 0), nil
+}
+
+// Avg returns the arithmetic mean of the values added.
+func (a *Int64Accumulator) Avg() Float {
+	if a.count == 0 {
+		return NewFloat(0, false)
+	}
+	return FloatFrom(a.fsum / float64(a.count))
+}
+
+// Min returns the smallest value added.
+func (a *Int64Accumulator) Min() Int64 {
+	return NewInt64(a.min, a.count > 0)
+}
+
+// Max returns the largest value added.
+func (a *Int64Accumulator) Max() Int64 {
+	return NewInt64(a.max, a.count > 0)
+}
+
+// Int32Accumulator computes SQL aggregates over a stream of Int32 values.
+// Like SQL, the sum is computed as a wider Int64.
+// Null values are skipped, and every aggregate except Count is null if no valid value was added.
+// The zero value is ready to use.
+type Int32Accumulator struct {
+	acc Int64Accumulator
+}
+
+// Add adds v to the aggregates. It does nothing if v is null.
+func (a *Int32Accumulator) Add(v Int32) {
+	a.acc.Add(NewInt64(int64(v.Int32), v.Valid))
+}
+
+// Count returns the number of non-null values added.
+func (a *Int32Accumulator) Count() int {
+	return a.acc.Count()
+}
+
+// Sum returns the sum of the values added.
+// It returns ErrOverflow if the sum does not fit in an int64.
+func (a *Int32Accumulator) Sum() (Int64, error) {
+	return a.acc.Sum()
+}
+
+// Avg returns the arithmetic mean of the values added.
+func (a *Int32Accumulator) Avg() Float {
+	return a.acc.Avg()
+}
+
+// Min returns the smallest value added.
+func (a *Int32Accumulator) Min() Int32 {
+	return NewInt32(int32(a.acc.min), a.acc.count > 0)
+}
+
+// Max returns the largest value added.
+func (a *Int32Accumulator) Max() Int32 {
+	return NewInt32(int32(a.acc.max), a.acc.count > 0)
+}
+
+// FloatAccumulator computes SQL aggregates over a stream of Float values.
+// Null values are skipped, and every aggregate except Count is null if no valid value was added.
+// The zero value is ready to use.
+type FloatAccumulator struct {
+	count    int
+	sum      float64
+	min, max float64
+}
+
+// Add adds v to the aggregates. It does nothing if v is null.
+func (a *FloatAccumulator) Add(v Float) {
+	if !v.Valid {
+		return
+	}
+	f := v.Float64
+	if a.count == 0 || f < a.min {
+		a.min = f
+	}
+	if a.count == 0 || f > a.max {
+		a.max = f
+	}
+	a.sum += f
+	a.count++
+}
+
+// Count returns the number of non-null values added.
+func (a *FloatAccumulator) Count() int {
+	return a.count
+}
+
+// Sum returns the sum of the values added.
+func (a *FloatAccumulator) Sum() Float {
+	return NewFloat(a.sum, a.count > 0)
+}
+
+// Avg returns the arithmetic mean of the values added.
+func (a *FloatAccumulator) Avg() Float {
+	if a.count == 0 {
+		return NewFloat(0, false)
+	}
+	return FloatFrom(a.sum / float64(a.count))
+}
+
+// Min returns the smallest value added.
+func (a *FloatAccumulator) Min() Float {
+	return NewFloat(a.min, a.count > 0)
+}
+
+// Max returns the largest value added.
+func (a *FloatAccumulator) Max() Float {
+	return NewFloat(a.max, a.count > 0)
+}
+
+// TimeAccumulator computes SQL aggregates over a stream of Time values.
+// Times are ordered by the instant they represent, regardless of location.
+// Null values are skipped, and every aggregate except Count is null if no valid value was added.
+// The zero value is ready to use.
+type TimeAccumulator struct {
+	count    int
+	min, max time.Time
+}
+
+// Add adds v to the aggregates. It does nothing if v is null.
+func (a *TimeAccumulator) Add(v Time) {
+	if !v.Valid {
+		return
+	}
+	if a.count == 0 || v.Time.Before(a.min) {
+		a.min = v.Time
+	}
+	if a.count == 0 || v.Time.After(a.max) {
+		a.max = v.Time
+	}
+	a.count++
+}
+
+// Count returns the number of non-null values added.
+func (a *TimeAccumulator) Count() int {
+	return a.count
+}
+
+// Min returns the earliest time added.
+func (a *TimeAccumulator) Min() Time {
+	return NewTime(a.min, a.count > 0)
+}
+
+// Max returns the latest time added.
+func (a *TimeAccumulator) Max() Time {
+	return NewTime(a.max, a.count > 0)
+}
+
+// SumInt32 returns the sum of the non-null values as an Int64, or null if there are none.
+func SumInt32(vals []Int32) (Int64, error) {
+	var a Int32Accumulator
+	for _, v := range vals {
+		a.Add(v)
+	}
+	return a.Sum()
+}
+
+// AvgInt32 returns the mean of the non-null values, or null if there are none.
+func AvgInt32(vals []Int32) Float {
+	var a Int32Accumulator
+	for _, v := range vals {
+		a.Add(v)
+	}
+	return a.Avg()
+}
+
+// MinInt32 returns the smallest non-null value, or null if there are none.
+func MinInt32(vals []Int32) Int32 {
+	var a Int32Accumulator
+	for _, v := range vals {
+		a.Add(v)
+	}
+	return a.Min()
+}
+
+// MaxInt32 returns the largest non-null value, or null if there are none.
+func MaxInt32(vals []Int32) Int32 {
+	var a Int32Accumulator
+	for _, v := range vals {
+		a.Add(v)
+	}
+	return a.Max()
+}
+
+// SumInt64 returns the sum of the non-null values, or null if there are none.
+// It returns ErrOverflow only if the final sum does not fit in an int64.
+func SumInt64(vals []Int64) (Int64, error) {
+	var a Int64Accumulator
+	for _, v := range vals {
+		a.Add(v)
+	}
+	return a.Sum()
+}
+
+// AvgInt64 returns the mean of the non-null values, or null if there are none.
+func AvgInt64(vals []Int64) Float {
+	var a Int64Accumulator
+	for _, v := range vals {
+		a.Add(v)
+	}
+	return a.Avg()
+}
+
+// MinInt64 returns the smallest non-null value, or null if there are none.
+func MinInt64(vals []Int64) Int64 {
+	var a Int64Accumulator
+	for _, v := range vals {
+		a.Add(v)
+	}
+	return a.Min()
+}
+
+// MaxInt64 returns the largest non-null value, or null if there are none.
+func MaxInt64(vals []Int64) Int64 {
+	var a Int64Accumulator
+	for _, v := range vals {
+		a.Add(v)
+	}
+	return a.Max()
+}
+
+// SumFloat returns the sum of the non-null values, or null if there are none.
+func SumFloat(vals []Float) Float {
+	var a FloatAccumulator
+	for _, v := range vals {
+		a.Add(v)
+	}
+	return a.Sum()
+}
+
+// AvgFloat returns the mean of the non-null values, or null if there are none.
+func AvgFloat(vals []Float) Float {
+	var a FloatAccumulator
+	for _, v := range vals {
+		a.Add(v)
+	}
+	return a.Avg()
+}
+
+// MinFloat returns the smallest non-null value, or null if there are none.
+func MinFloat(vals []Float) Float {
+	var a FloatAccumulator
+	for _, v := range vals {
+		a.Add(v)
+	}
+	return a.Min()
+}
+
+// MaxFloat returns the largest non-null value, or null if there are none.
+func MaxFloat(vals []Float) Float {
+	var a FloatAccumulator
+	for _, v := range vals {
+		a.Add(v)
+	}
+	return a.Max()
+}
+
+// MinTime returns the earliest non-null time, or null if there are none.
+func MinTime(vals []Time) Time {
+	var a TimeAccumulator
+	for _, v := range vals {
+		a.Add(v)
+	}
+	return a.Min()
+}
+
+// MaxTime returns the latest non-null time, or null if there are none.
+func MaxTime(vals []Time) Time {
+	var a TimeAccumulator
+	for _, v := range vals {
+		a.Add(v)
+	}
+	return a.Max()
+}
+
+// MinTimestamp returns the earliest non-null timestamp, or null if there are none.
+func MinTimestamp(vals []Timestamp) Timestamp {
+	var a TimeAccumulator
+	for _, v := range vals {
+		a.Add(Time(v))
+	}
+	return Timestamp(a.Min())
+}
+
+// MaxTimestamp returns the latest non-null timestamp, or null if there are none.
+func MaxTimestamp(vals []Timestamp) Timestamp {
+	var a TimeAccumulator
+	for _, v := range vals {
+		a.Add(Time(v))
+	}
+	return Timestamp(a.Max())
+}
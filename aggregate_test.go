@@ -0,0 +1,116 @@
+package null
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestCount(t *testing.T) {
+	vals := []Int64{Int64From(1), NewInt64(0, false), Int64From(0)}
+	if n := Count(vals); n != 2 {
+		t.Errorf("Count() = %d, want 2", n)
+	}
+	if n := Count([]String{NewString("", false)}); n != 0 {
+		t.Errorf("Count() = %d, want 0", n)
+	}
+}
+
+func TestInt64Aggregates(t *testing.T) {
+	vals := []Int64{Int64From(4), NewInt64(100, false), Int64From(-2), Int64From(7)}
+
+	sum, err := SumInt64(vals)
+	maybePanic(err)
+	assertInt64EqualIsTrue(t, sum, Int64From(9))
+	assertFloatEqualIsTrue(t, AvgInt64(vals), FloatFrom(3))
+	assertInt64EqualIsTrue(t, MinInt64(vals), Int64From(-2))
+	assertInt64EqualIsTrue(t, MaxInt64(vals), Int64From(7))
+
+	nulls := []Int64{NewInt64(0, false), NewInt64(1, false)}
+	sum, err = SumInt64(nulls)
+	maybePanic(err)
+	assertNullInt64(t, sum, "SumInt64() of nulls")
+	assertNullFloat(t, AvgInt64(nulls), "AvgInt64() of nulls")
+	assertNullInt64(t, MinInt64(nulls), "MinInt64() of nulls")
+	assertNullInt64(t, MaxInt64(nil), "MaxInt64() of nil")
+
+	// intermediate overflow is fine if the final sum fits
+	sum, err = SumInt64([]Int64{Int64From(math.MaxInt64), Int64From(1), Int64From(-1)})
+	maybePanic(err)
+	assertInt64EqualIsTrue(t, sum, Int64From(math.MaxInt64))
+	sum, err = SumInt64([]Int64{Int64From(math.MinInt64), Int64From(-1), Int64From(math.MaxInt64), Int64From(2)})
+	maybePanic(err)
+	assertInt64EqualIsTrue(t, sum, Int64From(0))
+
+	overflows := [][]Int64{
+		{Int64From(math.MaxInt64), Int64From(1)},
+		{Int64From(math.MinInt64), Int64From(-1)},
+		{Int64From(math.MaxInt64), Int64From(math.MaxInt64), Int64From(math.MaxInt64)},
+		{Int64From(math.MinInt64), Int64From(math.MinInt64), Int64From(math.MaxInt64)},
+	}
+	for _, vals := range overflows {
+		_, err = SumInt64(vals)
+		if !errors.Is(err, ErrOverflow) {
+			t.Errorf("SumInt64(%v): expected ErrOverflow, got %v", vals, err)
+		}
+	}
+}
+
+func TestInt32Aggregates(t *testing.T) {
+	vals := []Int32{Int32From(math.MaxInt32), NewInt32(0, false), Int32From(math.MaxInt32)}
+
+	sum, err := SumInt32(vals)
+	maybePanic(err)
+	assertInt64EqualIsTrue(t, sum, Int64From(2*math.MaxInt32))
+	assertFloatEqualIsTrue(t, AvgInt32(vals), FloatFrom(math.MaxInt32))
+	assertInt32EqualIsTrue(t, MinInt32(vals), Int32From(math.MaxInt32))
+	assertInt32EqualIsTrue(t, MaxInt32(vals), Int32From(math.MaxInt32))
+	assertNullInt32(t, MinInt32(nil), "MinInt32() of nil")
+}
+
+func TestFloatAggregates(t *testing.T) {
+	vals := []Float{FloatFrom(1.5), NewFloat(0, false), FloatFrom(-0.5), FloatFrom(2)}
+
+	assertFloatEqualIsTrue(t, SumFloat(vals), FloatFrom(3))
+	assertFloatEqualIsTrue(t, AvgFloat(vals), FloatFrom(1))
+	assertFloatEqualIsTrue(t, MinFloat(vals), FloatFrom(-0.5))
+	assertFloatEqualIsTrue(t, MaxFloat(vals), FloatFrom(2))
+
+	nulls := []Float{NewFloat(1, false)}
+	assertNullFloat(t, SumFloat(nulls), "SumFloat() of nulls")
+	assertNullFloat(t, AvgFloat(nulls), "AvgFloat() of nulls")
+	assertNullFloat(t, MinFloat(nulls), "MinFloat() of nulls")
+	assertNullFloat(t, MaxFloat(nulls), "MaxFloat() of nulls")
+}
+
+func TestTimeAggregates(t *testing.T) {
+	loc := time.FixedZone("UTC+2", 2*60*60)
+	early := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	late := time.Date(2020, 1, 1, 13, 30, 0, 0, loc) // 11:30 UTC
+	vals := []Time{TimeFrom(early), NewTime(time.Time{}, false), TimeFrom(late)}
+
+	assertTimeExactEqualIsTrue(t, MinTime(vals), TimeFrom(late))
+	assertTimeExactEqualIsTrue(t, MaxTime(vals), TimeFrom(early))
+	assertNullTime(t, MinTime(nil), "MinTime() of nil")
+
+	stamps := []Timestamp{TimestampFrom(early), NewTimestamp(time.Time{}, false), TimestampFrom(late)}
+	assertTimestampExactEqualIsTrue(t, MinTimestamp(stamps), TimestampFrom(late))
+	assertTimestampExactEqualIsTrue(t, MaxTimestamp(stamps), TimestampFrom(early))
+	assertNullTimestamp(t, MaxTimestamp(nil), "MaxTimestamp() of nil")
+}
+
+func TestAccumulator(t *testing.T) {
+	var a Int64Accumulator
+	assertNullInt64(t, a.Max(), "empty accumulator")
+	for _, v := range []Int64{Int64From(3), NewInt64(0, false), Int64From(5)} {
+		a.Add(v)
+	}
+	if a.Count() != 2 {
+		t.Errorf("Count() = %d, want 2", a.Count())
+	}
+	sum, err := a.Sum()
+	maybePanic(err)
+	assertInt64EqualIsTrue(t, sum, Int64From(8))
+	assertFloatEqualIsTrue(t, a.Avg(), FloatFrom(4))
+}
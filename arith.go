@@ -0,0 +1,305 @@
+package null
+
+import (
+	"errors"
+	"math"
+)
+
+// Arithmetic methods return an error only if they can fail for valid operands:
+// integer methods on overflow, and Div and Mod on division by zero.
+// DivOrNull and ModOrNull keep the (T, error) signature of Div and Mod for every type,
+// so they can be swapped for each other; the error of Float's versions is always nil.
+
+var (
+	// ErrOverflow is returned by arithmetic methods when the result of an integer operation
+	// does not fit in the operands' type.
+	ErrOverflow = errors.New("null: integer overflow")
+	// ErrDivisionByZero is returned by Div and Mod when the divisor is zero.
+	// Use DivOrNull and ModOrNull to get a null result instead.
+	ErrDivisionByZero = errors.New("null: division by zero")
+)
+
+// Add returns the sum of both Int64s, or null if either is null.
+// It returns ErrOverflow if the sum overflows an int64.
+func (i Int64) Add(other Int64) (Int64, error) {
+	if !i.Valid || !other.Valid {
+		return NewInt64(0, false), nil
+	}
+	n := i.Int64 + other.Int64
+	if (n > i.Int64) != (other.Int64 > 0) {
+		return NewInt64(0, false), ErrOverflow
+	}
+	return Int64From(n), nil
+}
+
+// Sub returns the difference of both Int64s, or null if either is null.
+// It returns ErrOverflow if the difference overflows an int64.
+func (i Int64) Sub(other Int64) (Int64, error) {
+	if !i.Valid || !other.Valid {
+		return NewInt64(0, false), nil
+	}
+	n := i.Int64 - other.Int64
+	if (n < i.Int64) != (other.Int64 > 0) {
+		return NewInt64(0, false), ErrOverflow
+	}
+	return Int64From(n), nil
+}
+
+// Mul returns the product of both Int64s, or null if either is null.
+// It returns ErrOverflow if the product overflows an int64.
+func (i Int64) Mul(other Int64) (Int64, error) {
+	if !i.Valid || !other.Valid {
+		return NewInt64(0, false), nil
+	}
+	a, b := i.Int64, other.Int64
+	if a == 0 || b == 0 {
+		return Int64From(0), nil
+	}
+	n := a * b
+	if n/b != a || (a == -1 && b == math.MinInt64) || (b == -1 && a == math.MinInt64) {
+		return NewInt64(0, false), ErrOverflow
+	}
+	return Int64From(n), nil
+}
+
+// Div returns the quotient of both Int64s truncated towards zero, or null if either is null.
+// It returns ErrDivisionByZero if other is zero and ErrOverflow if the quotient overflows an int64.
+func (i Int64) Div(other Int64) (Int64, error) {
+	if !i.Valid || !other.Valid {
+		return NewInt64(0, false), nil
+	}
+	if other.Int64 == 0 {
+		return NewInt64(0, false), ErrDivisionByZero
+	}
+	if i.Int64 == math.MinInt64 && other.Int64 == -1 {
+		return NewInt64(0, false), ErrOverflow
+	}
+	return Int64From(i.Int64 / other.Int64), nil
+}
+
+// DivOrNull is like Div, but returns null instead of an error if other is zero.
+func (i Int64) DivOrNull(other Int64) (Int64, error) {
+	if other.Valid && other.Int64 == 0 {
+		return NewInt64(0, false), nil
+	}
+	return i.Div(other)
+}
+
+// Mod returns the remainder of dividing both Int64s, or null if either is null.
+// The result has the sign of i, like Go's % operator.
+// It returns ErrDivisionByZero if other is zero.
+func (i Int64) Mod(other Int64) (Int64, error) {
+	if !i.Valid || !other.Valid {
+		return NewInt64(0, false), nil
+	}
+	if other.Int64 == 0 {
+		return NewInt64(0, false), ErrDivisionByZero
+	}
+	return Int64From(i.Int64 % other.Int64), nil
+}
+
+// ModOrNull is like Mod, but returns null instead of an error if other is zero.
+func (i Int64) ModOrNull(other Int64) (Int64, error) {
+	if other.Valid && other.Int64 == 0 {
+		return NewInt64(0, false), nil
+	}
+	return i.Mod(other)
+}
+
+// Neg returns the negation of this Int64, or null if it is null.
+// It returns ErrOverflow for math.MinInt64.
+func (i Int64) Neg() (Int64, error) {
+	if !i.Valid {
+		return NewInt64(0, false), nil
+	}
+	if i.Int64 == math.MinInt64 {
+		return NewInt64(0, false), ErrOverflow
+	}
+	return Int64From(-i.Int64), nil
+}
+
+// Abs returns the absolute value of this Int64, or null if it is null.
+// It returns ErrOverflow for math.MinInt64.
+func (i Int64) Abs() (Int64, error) {
+	if i.Valid && i.Int64 < 0 {
+		return i.Neg()
+	}
+	return i, nil
+}
+
+// Add returns the sum of both Int32s, or null if either is null.
+// It returns ErrOverflow if the sum overflows an int32.
+func (i Int32) Add(other Int32) (Int32, error) {
+	if !i.Valid || !other.Valid {
+		return NewInt32(0, false), nil
+	}
+	return int32Result(int64(i.Int32) + int64(other.Int32))
+}
+
+// Sub returns the difference of both Int32s, or null if either is null.
+// It returns ErrOverflow if the difference overflows an int32.
+func (i Int32) Sub(other Int32) (Int32, error) {
+	if !i.Valid || !other.Valid {
+		return NewInt32(0, false), nil
+	}
+	return int32Result(int64(i.Int32) - int64(other.Int32))
+}
+
+// Mul returns the product of both Int32s, or null if either is null.
+// It returns ErrOverflow if the product overflows an int32.
+func (i Int32) Mul(other Int32) (Int32, error) {
+	if !i.Valid || !other.Valid {
+		return NewInt32(0, false), nil
+	}
+	return int32Result(int64(i.Int32) * int64(other.Int32))
+}
+
+// Div returns the quotient of both Int32s truncated towards zero, or null if either is null.
+// It returns ErrDivisionByZero if other is zero and ErrOverflow if the quotient overflows an int32.
+func (i Int32) Div(other Int32) (Int32, error) {
+	if !i.Valid || !other.Valid {
+		return NewInt32(0, false), nil
+	}
+	if other.Int32 == 0 {
+		return NewInt32(0, false), ErrDivisionByZero
+	}
+	return int32Result(int64(i.Int32) / int64(other.Int32))
+}
+
+// DivOrNull is like Div, but returns null instead of an error if other is zero.
+func (i Int32) DivOrNull(other Int32) (Int32, error) {
+	if other.Valid && other.Int32 == 0 {
+		return NewInt32(0, false), nil
+	}
+	return i.Div(other)
+}
+
+// Mod returns the remainder of dividing both Int32s, or null if either is null.
+// The result has the sign of i, like Go's % operator.
+// It returns ErrDivisionByZero if other is zero.
+func (i Int32) Mod(other Int32) (Int32, error) {
+	if !i.Valid || !other.Valid {
+		return NewInt32(0, false), nil
+	}
+	if other.Int32 == 0 {
+		return NewInt32(0, false), ErrDivisionByZero
+	}
+	return Int32From(i.Int32 % other.Int32), nil
+}
+
+// ModOrNull is like Mod, but returns null instead of an error if other is zero.
+func (i Int32) ModOrNull(other Int32) (Int32, error) {
+	if other.Valid && other.Int32 == 0 {
+		return NewInt32(0, false), nil
+	}
+	return i.Mod(other)
+}
+
+// Neg returns the negation of this Int32, or null if it is null.
+// It returns ErrOverflow for math.MinInt32.
+func (i Int32) Neg() (Int32, error) {
+	if !i.Valid {
+		return NewInt32(0, false), nil
+	}
+	return int32Result(-int64(i.Int32))
+}
+
+// Abs returns the absolute value of this Int32, or null if it is null.
+// It returns ErrOverflow for math.MinInt32.
+func (i Int32) Abs() (Int32, error) {
+	if i.Valid && i.Int32 < 0 {
+		return i.Neg()
+	}
+	return i, nil
+}
+
+// int32Result range checks the result of an Int32 operation computed as int64.
+func int32Result(n int64) (Int32, error) {
+	if n < math.MinInt32 || n > math.MaxInt32 {
+		return NewInt32(0, false), ErrOverflow
+	}
+	return Int32From(int32(n)), nil
+}
+
+// Add returns the sum of both Floats, or null if either is null.
+func (f Float) Add(other Float) Float {
+	if !f.Valid || !other.Valid {
+		return NewFloat(0, false)
+	}
+	return FloatFrom(f.Float64 + other.Float64)
+}
+
+// Sub returns the difference of both Floats, or null if either is null.
+func (f Float) Sub(other Float) Float {
+	if !f.Valid || !other.Valid {
+		return NewFloat(0, false)
+	}
+	return FloatFrom(f.Float64 - other.Float64)
+}
+
+// Mul returns the product of both Floats, or null if either is null.
+func (f Float) Mul(other Float) Float {
+	if !f.Valid || !other.Valid {
+		return NewFloat(0, false)
+	}
+	return FloatFrom(f.Float64 * other.Float64)
+}
+
+// Div returns the quotient of both Floats, or null if either is null.
+// Unlike IEEE 754 division, it returns ErrDivisionByZero if other is zero instead of an infinity or NaN.
+func (f Float) Div(other Float) (Float, error) {
+	if !f.Valid || !other.Valid {
+		return NewFloat(0, false), nil
+	}
+	if other.Float64 == 0 {
+		return NewFloat(0, false), ErrDivisionByZero
+	}
+	return FloatFrom(f.Float64 / other.Float64), nil
+}
+
+// DivOrNull is like Div, but returns null instead of an error if other is zero.
+// The error is always nil.
+func (f Float) DivOrNull(other Float) (Float, error) {
+	if other.Valid && other.Float64 == 0 {
+		return NewFloat(0, false), nil
+	}
+	return f.Div(other)
+}
+
+// Mod returns the floating-point remainder of dividing both Floats as computed by math.Mod,
+// or null if either is null.
+// It returns ErrDivisionByZero if other is zero.
+func (f Float) Mod(other Float) (Float, error) {
+	if !f.Valid || !other.Valid {
+		return NewFloat(0, false), nil
+	}
+	if other.Float64 == 0 {
+		return NewFloat(0, false), ErrDivisionByZero
+	}
+	return FloatFrom(math.Mod(f.Float64, other.Float64)), nil
+}
+
+// ModOrNull is like Mod, but returns null instead of an error if other is zero.
+// The error is always nil.
+func (f Float) ModOrNull(other Float) (Float, error) {
+	if other.Valid && other.Float64 == 0 {
+		return NewFloat(0, false), nil
+	}
+	return f.Mod(other)
+}
+
+// Neg returns the negation of this Float, or null if it is null.
+func (f Float) Neg() Float {
+	if !f.Valid {
+		return NewFloat(0, false)
+	}
+	return FloatFrom(-f.Float64)
+}
+
+// Abs returns the absolute value of this Float, or null if it is null.
+func (f Float) Abs() Float {
+	if !f.Valid {
+		return NewFloat(0, false)
+	}
+	return FloatFrom(math.Abs(f.Float64))
+}
@@ -0,0 +1,144 @@
+package null
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestInt64Arithmetic(t *testing.T) {
+	a, b, null := Int64From(7), Int64From(-2), NewInt64(0, false)
+
+	tests := []struct {
+		name string
+		op   func() (Int64, error)
+		want Int64
+		err  error
+	}{
+		{"add", func() (Int64, error) { return a.Add(b) }, Int64From(5), nil},
+		{"sub", func() (Int64, error) { return a.Sub(b) }, Int64From(9), nil},
+		{"mul", func() (Int64, error) { return a.Mul(b) }, Int64From(-14), nil},
+		{"div", func() (Int64, error) { return a.Div(b) }, Int64From(-3), nil},
+		{"mod", func() (Int64, error) { return a.Mod(b) }, Int64From(1), nil},
+		{"neg", func() (Int64, error) { return b.Neg() }, Int64From(2), nil},
+		{"abs", func() (Int64, error) { return b.Abs() }, Int64From(2), nil},
+
+		{"add null", func() (Int64, error) { return a.Add(null) }, null, nil},
+		{"sub null", func() (Int64, error) { return null.Sub(a) }, null, nil},
+		{"mul null", func() (Int64, error) { return a.Mul(null) }, null, nil},
+		{"div null by zero", func() (Int64, error) { return null.Div(Int64From(0)) }, null, nil},
+		{"neg null", func() (Int64, error) { return null.Neg() }, null, nil},
+		{"abs null", func() (Int64, error) { return null.Abs() }, null, nil},
+
+		{"div by zero", func() (Int64, error) { return a.Div(Int64From(0)) }, null, ErrDivisionByZero},
+		{"mod by zero", func() (Int64, error) { return a.Mod(Int64From(0)) }, null, ErrDivisionByZero},
+		{"div or null by zero", func() (Int64, error) { return a.DivOrNull(Int64From(0)) }, null, nil},
+		{"mod or null by zero", func() (Int64, error) { return a.ModOrNull(Int64From(0)) }, null, nil},
+
+		{"add overflow", func() (Int64, error) { return Int64From(math.MaxInt64).Add(Int64From(1)) }, null, ErrOverflow},
+		{"add underflow", func() (Int64, error) { return Int64From(math.MinInt64).Add(Int64From(-1)) }, null, ErrOverflow},
+		{"sub overflow", func() (Int64, error) { return Int64From(math.MinInt64).Sub(Int64From(1)) }, null, ErrOverflow},
+		{"mul overflow", func() (Int64, error) { return Int64From(math.MaxInt64 / 2).Mul(Int64From(3)) }, null, ErrOverflow},
+		{"mul min overflow", func() (Int64, error) { return Int64From(math.MinInt64).Mul(Int64From(-1)) }, null, ErrOverflow},
+		{"div overflow", func() (Int64, error) { return Int64From(math.MinInt64).Div(Int64From(-1)) }, null, ErrOverflow},
+		{"neg overflow", func() (Int64, error) { return Int64From(math.MinInt64).Neg() }, null, ErrOverflow},
+		{"abs overflow", func() (Int64, error) { return Int64From(math.MinInt64).Abs() }, null, ErrOverflow},
+		{"mul min by one", func() (Int64, error) { return Int64From(math.MinInt64).Mul(Int64From(1)) }, Int64From(math.MinInt64), nil},
+	}
+	for _, test := range tests {
+		got, err := test.op()
+		if !errors.Is(err, test.err) {
+			t.Errorf("%s: unexpected error %v, want %v", test.name, err, test.err)
+		}
+		if !got.Equal(test.want) {
+			t.Errorf("%s: got %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestInt32Arithmetic(t *testing.T) {
+	a, b, null := Int32From(7), Int32From(-2), NewInt32(0, false)
+
+	tests := []struct {
+		name string
+		op   func() (Int32, error)
+		want Int32
+		err  error
+	}{
+		{"add", func() (Int32, error) { return a.Add(b) }, Int32From(5), nil},
+		{"sub", func() (Int32, error) { return a.Sub(b) }, Int32From(9), nil},
+		{"mul", func() (Int32, error) { return a.Mul(b) }, Int32From(-14), nil},
+		{"div", func() (Int32, error) { return a.Div(b) }, Int32From(-3), nil},
+		{"mod", func() (Int32, error) { return a.Mod(b) }, Int32From(1), nil},
+		{"neg", func() (Int32, error) { return b.Neg() }, Int32From(2), nil},
+		{"abs", func() (Int32, error) { return b.Abs() }, Int32From(2), nil},
+
+		{"add null", func() (Int32, error) { return a.Add(null) }, null, nil},
+		{"div by zero", func() (Int32, error) { return a.Div(Int32From(0)) }, null, ErrDivisionByZero},
+		{"mod by zero", func() (Int32, error) { return a.Mod(Int32From(0)) }, null, ErrDivisionByZero},
+		{"div or null by zero", func() (Int32, error) { return a.DivOrNull(Int32From(0)) }, null, nil},
+		{"mod or null by zero", func() (Int32, error) { return a.ModOrNull(Int32From(0)) }, null, nil},
+
+		{"add overflow", func() (Int32, error) { return Int32From(math.MaxInt32).Add(Int32From(1)) }, null, ErrOverflow},
+		{"sub overflow", func() (Int32, error) { return Int32From(math.MinInt32).Sub(Int32From(1)) }, null, ErrOverflow},
+		{"mul overflow", func() (Int32, error) { return Int32From(1 << 16).Mul(Int32From(1 << 16)) }, null, ErrOverflow},
+		{"div overflow", func() (Int32, error) { return Int32From(math.MinInt32).Div(Int32From(-1)) }, null, ErrOverflow},
+		{"neg overflow", func() (Int32, error) { return Int32From(math.MinInt32).Neg() }, null, ErrOverflow},
+	}
+	for _, test := range tests {
+		got, err := test.op()
+		if !errors.Is(err, test.err) {
+			t.Errorf("%s: unexpected error %v, want %v", test.name, err, test.err)
+		}
+		if !got.Equal(test.want) {
+			t.Errorf("%s: got %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestFloatArithmetic(t *testing.T) {
+	a, b, null := FloatFrom(7.5), FloatFrom(-2), NewFloat(0, false)
+
+	assertFloatEqualIsTrue(t, a.Add(b), FloatFrom(5.5))
+	assertFloatEqualIsTrue(t, a.Sub(b), FloatFrom(9.5))
+	assertFloatEqualIsTrue(t, a.Mul(b), FloatFrom(-15))
+	assertFloatEqualIsTrue(t, b.Neg(), FloatFrom(2))
+	assertFloatEqualIsTrue(t, b.Abs(), FloatFrom(2))
+	assertFloatEqualIsTrue(t, a.Add(null), null)
+	assertFloatEqualIsTrue(t, null.Mul(b), null)
+	assertFloatEqualIsTrue(t, null.Neg(), null)
+	assertFloatEqualIsTrue(t, null.Abs(), null)
+
+	q, err := a.Div(b)
+	maybePanic(err)
+	assertFloatEqualIsTrue(t, q, FloatFrom(-3.75))
+
+	r, err := a.Mod(b)
+	maybePanic(err)
+	assertFloatEqualIsTrue(t, r, FloatFrom(1.5))
+
+	q, err = a.Div(FloatFrom(0))
+	if !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("expected ErrDivisionByZero, got %v", err)
+	}
+	assertNullFloat(t, q, "Div() by zero")
+
+	_, err = a.Mod(FloatFrom(0))
+	if !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("expected ErrDivisionByZero, got %v", err)
+	}
+
+	q, err = null.Div(FloatFrom(0))
+	maybePanic(err)
+	assertNullFloat(t, q, "Div() null by zero")
+
+	q, err = a.DivOrNull(FloatFrom(0))
+	maybePanic(err)
+	assertNullFloat(t, q, "DivOrNull() by zero")
+	q, err = a.ModOrNull(FloatFrom(0))
+	maybePanic(err)
+	assertNullFloat(t, q, "ModOrNull() by zero")
+	q, err = a.DivOrNull(b)
+	maybePanic(err)
+	assertFloatEqualIsTrue(t, q, FloatFrom(-3.75))
+}
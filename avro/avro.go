@@ -0,0 +1,160 @@
+// Package avro encodes and decodes the types of github.com/vitdevelop/null in Avro binary format,
+// as unions of null and the type of the value, without reflection or dependencies.
+//
+// For every type there is an Append function, which appends a value to a byte slice,
+// and a Read function, which reads a value from the start of a byte slice and returns the remaining bytes:
+//
+//	b = avro.AppendInt64(b, id)
+//	b = avro.AppendString(b, name)
+//	...
+//	id, b, err = avro.ReadInt64(b)
+//	name, b, err = avro.ReadString(b)
+//
+// A value is encoded as the index of its union branch, 0 for null and 1 for a valid value,
+// followed by the value itself for branch 1. The unions are:
+//
+//	null.Int64        ["null", "long"]
+//	null.Int32        ["null", "int"]
+//	null.Float        ["null", "double"]
+//	null.Bool         ["null", "boolean"]
+//	null.LenientBool  ["null", "boolean"]
+//	null.String       ["null", "string"]
+//	null.Time         ["null", {"type": "long", "logicalType": "timestamp-millis"}]
+//	null.Timestamp    ["null", {"type": "long", "logicalType": "timestamp-millis"}]
+//
+// Schema and Field return these schemas as JSON, for writing the schema of a record.
+// Times are encoded in milliseconds since the Unix epoch, so they are decoded in UTC and
+// lose any sub-millisecond precision.
+package avro
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+var (
+	// ErrShortBytes is returned when the input ends in the middle of a value.
+	ErrShortBytes = errors.New("avro: too few bytes left to read value")
+	// ErrOverflow is returned when a varint is longer than 64 bits, or an int doesn't fit in 32 bits.
+	ErrOverflow = errors.New("avro: integer overflow")
+	// ErrBranch is returned, wrapped, when a union branch index is neither 0 nor 1.
+	ErrBranch = errors.New("avro: invalid union branch")
+)
+
+// union branch indexes
+const (
+	branchNull  = 0
+	branchValue = 1
+)
+
+// need returns ErrShortBytes if b has less than n bytes.
+func need(b []byte, n int) error {
+	if len(b) < n {
+		return ErrShortBytes
+	}
+	return nil
+}
+
+// appendLong appends v as a zigzag-encoded varint.
+func appendLong(b []byte, v int64) []byte {
+	u := uint64(v<<1) ^ uint64(v>>63)
+	for u >= 0x80 {
+		b = append(b, byte(u)|0x80)
+		u >>= 7
+	}
+	return append(b, byte(u))
+}
+
+func appendDouble(b []byte, f float64) []byte {
+	return binary.LittleEndian.AppendUint64(b, math.Float64bits(f))
+}
+
+func appendBoolean(b []byte, v bool) []byte {
+	if v {
+		return append(b, 1)
+	}
+	return append(b, 0)
+}
+
+func appendString(b []byte, s string) []byte {
+	return append(appendLong(b, int64(len(s))), s...)
+}
+
+// readLong reads a zigzag-encoded varint.
+func readLong(b []byte) (int64, []byte, error) {
+	var u uint64
+	for i := 0; i < len(b); i++ {
+		c := b[i]
+		if i == 9 && c > 1 {
+			return 0, b, ErrOverflow
+		}
+		u |= uint64(c&0x7f) << (7 * i)
+		if c < 0x80 {
+			return int64(u>>1) ^ -int64(u&1), b[i+1:], nil
+		}
+	}
+	return 0, b, ErrShortBytes
+}
+
+// readInt reads a zigzag-encoded varint, returning ErrOverflow if it doesn't fit in an int32.
+func readInt(b []byte) (int32, []byte, error) {
+	n, rest, err := readLong(b)
+	if err != nil {
+		return 0, b, err
+	}
+	if n < math.MinInt32 || n > math.MaxInt32 {
+		return 0, b, ErrOverflow
+	}
+	return int32(n), rest, nil
+}
+
+func readDouble(b []byte) (float64, []byte, error) {
+	if err := need(b, 8); err != nil {
+		return 0, b, err
+	}
+	return math.Float64frombits(binary.LittleEndian.Uint64(b)), b[8:], nil
+}
+
+func readBoolean(b []byte) (bool, []byte, error) {
+	if err := need(b, 1); err != nil {
+		return false, b, err
+	}
+	switch b[0] {
+	case 0:
+		return false, b[1:], nil
+	case 1:
+		return true, b[1:], nil
+	}
+	return false, b, fmt.Errorf("avro: invalid boolean byte %d", b[0])
+}
+
+func readString(b []byte) (string, []byte, error) {
+	n, rest, err := readLong(b)
+	if err != nil {
+		return "", b, err
+	}
+	if n < 0 {
+		return "", b, fmt.Errorf("avro: invalid string length %d", n)
+	}
+	if uint64(len(rest)) < uint64(n) {
+		return "", b, ErrShortBytes
+	}
+	return string(rest[:n]), rest[n:], nil
+}
+
+// readBranch reads a union branch index, returning whether it is the value branch.
+func readBranch(b []byte) (bool, []byte, error) {
+	n, rest, err := readLong(b)
+	if err != nil {
+		return false, b, err
+	}
+	switch n {
+	case branchNull:
+		return false, rest, nil
+	case branchValue:
+		return true, rest, nil
+	}
+	return false, b, fmt.Errorf("%w: got %d, need 0 or 1", ErrBranch, n)
+}
@@ -0,0 +1,92 @@
+package avro
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestLong(t *testing.T) {
+	// examples from the Avro specification
+	tests := []struct {
+		v    int64
+		want string
+	}{
+		{0, "00"},
+		{-1, "01"},
+		{1, "02"},
+		{-2, "03"},
+		{2, "04"},
+		{-64, "7f"},
+		{64, "8001"},
+		{math.MaxInt64, "feffffffffffffffff01"},
+		{math.MinInt64, "ffffffffffffffffff01"},
+	}
+	for _, test := range tests {
+		b := appendLong(nil, test.v)
+		if got := hex.EncodeToString(b); got != test.want {
+			t.Errorf("appendLong(%d): got %s, want %s", test.v, got, test.want)
+		}
+		n, rest, err := readLong(append(b, 0x02))
+		if err != nil || n != test.v || !bytes.Equal(rest, []byte{0x02}) {
+			t.Errorf("readLong(% x): got %d, % x, %v", b, n, rest, err)
+		}
+	}
+}
+
+func TestString(t *testing.T) {
+	b := appendString(nil, "foo")
+	if got, want := hex.EncodeToString(b), "06666f6f"; got != want {
+		t.Errorf("appendString(foo): got %s, want %s", got, want)
+	}
+	s, rest, err := readString(b)
+	if err != nil || s != "foo" || len(rest) != 0 {
+		t.Errorf("readString(% x): got %q, % x, %v", b, s, rest, err)
+	}
+}
+
+func TestReadErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		read func([]byte) error
+		s    string
+		err  error
+	}{
+		{"long", readErr(readLong), "80", ErrShortBytes},
+		{"long 65 bits", readErr(readLong), "ffffffffffffffffff02", ErrOverflow},
+		{"int", readErr(readInt), "8080808010", ErrOverflow},
+		{"double", readErr(readDouble), "00000000", ErrShortBytes},
+		{"boolean", readErr(readBoolean), "", ErrShortBytes},
+		{"boolean byte", readErr(readBoolean), "02", nil},
+		{"string", readErr(readString), "0661", ErrShortBytes},
+		{"string length", readErr(readString), "01", nil},
+		{"branch", readErr(readBranch), "04", ErrBranch},
+		{"negative branch", readErr(readBranch), "01", ErrBranch},
+	}
+	for _, test := range tests {
+		err := test.read(decodeHex(test.s))
+		if err == nil || (test.err != nil && !errors.Is(err, test.err)) {
+			t.Errorf("%s(%s): got %v, want error %v", test.name, test.s, err, test.err)
+		}
+	}
+}
+
+func readErr[T any](read func([]byte) (T, []byte, error)) func([]byte) error {
+	return func(b []byte) error {
+		_, rest, err := read(b)
+		if err != nil && !bytes.Equal(rest, b) {
+			return errors.New("input not returned on error")
+		}
+		return err
+	}
+}
+
+func decodeHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
@@ -0,0 +1,168 @@
+package avro
+
+import (
+	"time"
+
+	"github.com/vitdevelop/null"
+)
+
+// AppendInt64 appends v to b as a ["null", "long"] union.
+func AppendInt64(b []byte, v null.Int64) []byte {
+	if !v.Valid {
+		return appendLong(b, branchNull)
+	}
+	return appendLong(appendLong(b, branchValue), v.Int64)
+}
+
+// ReadInt64 reads a ["null", "long"] union from the start of b, returning the remaining bytes.
+func ReadInt64(b []byte) (null.Int64, []byte, error) {
+	valid, rest, err := readBranch(b)
+	if err != nil || !valid {
+		return null.NewInt64(0, false), rest, err
+	}
+	n, rest, err := readLong(rest)
+	if err != nil {
+		return null.Int64{}, b, err
+	}
+	return null.Int64From(n), rest, nil
+}
+
+// AppendInt32 appends v to b as a ["null", "int"] union.
+func AppendInt32(b []byte, v null.Int32) []byte {
+	if !v.Valid {
+		return appendLong(b, branchNull)
+	}
+	return appendLong(appendLong(b, branchValue), int64(v.Int32))
+}
+
+// ReadInt32 reads a ["null", "int"] union from the start of b, returning the remaining bytes.
+// It returns ErrOverflow if the value doesn't fit in an int32.
+func ReadInt32(b []byte) (null.Int32, []byte, error) {
+	valid, rest, err := readBranch(b)
+	if err != nil || !valid {
+		return null.NewInt32(0, false), rest, err
+	}
+	n, rest, err := readInt(rest)
+	if err != nil {
+		return null.Int32{}, b, err
+	}
+	return null.Int32From(n), rest, nil
+}
+
+// AppendFloat appends v to b as a ["null", "double"] union.
+func AppendFloat(b []byte, v null.Float) []byte {
+	if !v.Valid {
+		return appendLong(b, branchNull)
+	}
+	return appendDouble(appendLong(b, branchValue), v.Float64)
+}
+
+// ReadFloat reads a ["null", "double"] union from the start of b, returning the remaining bytes.
+func ReadFloat(b []byte) (null.Float, []byte, error) {
+	valid, rest, err := readBranch(b)
+	if err != nil || !valid {
+		return null.NewFloat(0, false), rest, err
+	}
+	f, rest, err := readDouble(rest)
+	if err != nil {
+		return null.Float{}, b, err
+	}
+	return null.FloatFrom(f), rest, nil
+}
+
+// AppendBool appends v to b as a ["null", "boolean"] union.
+func AppendBool(b []byte, v null.Bool) []byte {
+	if !v.Valid {
+		return appendLong(b, branchNull)
+	}
+	return appendBoolean(appendLong(b, branchValue), v.Bool)
+}
+
+// ReadBool reads a ["null", "boolean"] union from the start of b, returning the remaining bytes.
+func ReadBool(b []byte) (null.Bool, []byte, error) {
+	valid, rest, err := readBranch(b)
+	if err != nil || !valid {
+		return null.NewBool(false, false), rest, err
+	}
+	v, rest, err := readBoolean(rest)
+	if err != nil {
+		return null.Bool{}, b, err
+	}
+	return null.BoolFrom(v), rest, nil
+}
+
+// AppendLenientBool appends v to b as a ["null", "boolean"] union.
+func AppendLenientBool(b []byte, v null.LenientBool) []byte {
+	return AppendBool(b, v.Bool)
+}
+
+// ReadLenientBool reads a ["null", "boolean"] union from the start of b, returning the remaining bytes.
+func ReadLenientBool(b []byte) (null.LenientBool, []byte, error) {
+	v, rest, err := ReadBool(b)
+	return null.LenientBool{Bool: v}, rest, err
+}
+
+// AppendString appends v to b as a ["null", "string"] union.
+func AppendString(b []byte, v null.String) []byte {
+	if !v.Valid {
+		return appendLong(b, branchNull)
+	}
+	return appendString(appendLong(b, branchValue), v.String)
+}
+
+// ReadString reads a ["null", "string"] union from the start of b, returning the remaining bytes.
+func ReadString(b []byte) (null.String, []byte, error) {
+	valid, rest, err := readBranch(b)
+	if err != nil || !valid {
+		return null.NewString("", false), rest, err
+	}
+	s, rest, err := readString(rest)
+	if err != nil {
+		return null.String{}, b, err
+	}
+	return null.StringFrom(s), rest, nil
+}
+
+// AppendTime appends v to b as a union of null and a timestamp-millis long.
+func AppendTime(b []byte, v null.Time) []byte {
+	if !v.Valid {
+		return appendLong(b, branchNull)
+	}
+	return appendLong(appendLong(b, branchValue), v.Time.UnixMilli())
+}
+
+// ReadTime reads a union of null and a timestamp-millis long from the start of b, returning the remaining bytes.
+// The time is in UTC.
+func ReadTime(b []byte) (null.Time, []byte, error) {
+	valid, rest, err := readBranch(b)
+	if err != nil || !valid {
+		return null.NewTime(time.Time{}, false), rest, err
+	}
+	ms, rest, err := readLong(rest)
+	if err != nil {
+		return null.Time{}, b, err
+	}
+	return null.TimeFrom(time.UnixMilli(ms).UTC()), rest, nil
+}
+
+// AppendTimestamp appends v to b as a union of null and a timestamp-millis long.
+func AppendTimestamp(b []byte, v null.Timestamp) []byte {
+	if !v.Valid {
+		return appendLong(b, branchNull)
+	}
+	return appendLong(appendLong(b, branchValue), v.Time.UnixMilli())
+}
+
+// ReadTimestamp reads a union of null and a timestamp-millis long from the start of b, returning the remaining bytes.
+// The time is in UTC.
+func ReadTimestamp(b []byte) (null.Timestamp, []byte, error) {
+	valid, rest, err := readBranch(b)
+	if err != nil || !valid {
+		return null.NewTimestamp(time.Time{}, false), rest, err
+	}
+	ms, rest, err := readLong(rest)
+	if err != nil {
+		return null.Timestamp{}, b, err
+	}
+	return null.TimestampFrom(time.UnixMilli(ms).UTC()), rest, nil
+}
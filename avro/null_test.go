@@ -0,0 +1,129 @@
+package avro
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/vitdevelop/null"
+)
+
+func TestNullRoundTrip(t *testing.T) {
+	created := time.Date(2012, 12, 21, 21, 21, 21, 123000000, time.UTC)
+	for _, valid := range []bool{true, false} {
+		var b []byte
+		b = AppendInt64(b, null.NewInt64(math.MinInt64, valid))
+		b = AppendInt32(b, null.NewInt32(math.MaxInt32, valid))
+		b = AppendFloat(b, null.NewFloat(1.2345, valid))
+		b = AppendBool(b, null.NewBool(false, valid))
+		b = AppendLenientBool(b, null.NewLenientBool(true, valid))
+		b = AppendString(b, null.NewString("", valid))
+		b = AppendTime(b, null.NewTime(created, valid))
+		b = AppendTimestamp(b, null.NewTimestamp(created, valid))
+
+		i, b, err := ReadInt64(b)
+		maybePanic(err)
+		assertValid(t, i.Valid, valid, i.Int64 == math.MinInt64, "Int64")
+		i32, b, err := ReadInt32(b)
+		maybePanic(err)
+		assertValid(t, i32.Valid, valid, i32.Int32 == math.MaxInt32, "Int32")
+		f, b, err := ReadFloat(b)
+		maybePanic(err)
+		assertValid(t, f.Valid, valid, f.Float64 == 1.2345, "Float")
+		bo, b, err := ReadBool(b)
+		maybePanic(err)
+		assertValid(t, bo.Valid, valid, !bo.Bool, "Bool")
+		lb, b, err := ReadLenientBool(b)
+		maybePanic(err)
+		assertValid(t, lb.Valid, valid, lb.Bool.Bool, "LenientBool")
+		s, b, err := ReadString(b)
+		maybePanic(err)
+		assertValid(t, s.Valid, valid, s.String == "", "String")
+		ti, b, err := ReadTime(b)
+		maybePanic(err)
+		assertValid(t, ti.Valid, valid, ti.Time.Equal(created), "Time")
+		ts, b, err := ReadTimestamp(b)
+		maybePanic(err)
+		assertValid(t, ts.Valid, valid, ts.Time.Equal(created), "Timestamp")
+
+		if len(b) != 0 {
+			t.Errorf("%d bytes left over", len(b))
+		}
+	}
+}
+
+func TestAppendNull(t *testing.T) {
+	tests := []struct {
+		b    []byte
+		want string
+	}{
+		{AppendInt64(nil, null.Int64From(1)), "0202"},
+		{AppendInt64(nil, null.Int64From(-1)), "0201"},
+		{AppendInt64(nil, null.Int64{}), "00"},
+		{AppendInt32(nil, null.Int32From(64)), "028001"},
+		{AppendFloat(nil, null.FloatFrom(1)), "02000000000000f03f"},
+		{AppendBool(nil, null.BoolFrom(true)), "0201"},
+		{AppendString(nil, null.StringFrom("foo")), "0206666f6f"},
+		{AppendString(nil, null.String{}), "00"},
+		{AppendTime(nil, null.TimeFrom(time.UnixMilli(1))), "0202"},
+		{AppendTimestamp(nil, null.TimestampFrom(time.UnixMilli(-1))), "0201"},
+	}
+	for _, test := range tests {
+		if !bytes.Equal(test.b, decodeHex(test.want)) {
+			t.Errorf("got % x, want %s", test.b, test.want)
+		}
+	}
+}
+
+func TestTimeMillis(t *testing.T) {
+	// sub-millisecond precision is truncated, and the time is read in UTC
+	local := time.Date(2012, 12, 21, 22, 21, 21, 123456789, time.FixedZone("", 3600))
+	ti, _, err := ReadTime(AppendTime(nil, null.TimeFrom(local)))
+	maybePanic(err)
+	assertValid(t, ti.Valid, true, ti.Time.Equal(local.Truncate(time.Millisecond)) && ti.Time.Location() == time.UTC, "Time")
+
+	before := time.UnixMilli(-1500).Add(-time.Microsecond)
+	ts, _, err := ReadTimestamp(AppendTimestamp(nil, null.TimestampFrom(before)))
+	maybePanic(err)
+	assertValid(t, ts.Valid, true, ts.Time.Equal(time.UnixMilli(-1501)), "Timestamp before epoch")
+}
+
+func TestReadInt32Overflow(t *testing.T) {
+	b := AppendInt64(nil, null.Int64From(math.MaxInt32+1))
+	if _, rest, err := ReadInt32(b); !errors.Is(err, ErrOverflow) || !bytes.Equal(rest, b) {
+		t.Errorf("ReadInt32(MaxInt32+1): got %v, want ErrOverflow", err)
+	}
+}
+
+func TestReadNullErrors(t *testing.T) {
+	// branch 2 of ["null", "long", "string"] is not supported
+	b := decodeHex("0406666f6f")
+	if _, rest, err := ReadInt64(b); !errors.Is(err, ErrBranch) || !bytes.Equal(rest, b) {
+		t.Errorf("ReadInt64(branch 2): got %v, want ErrBranch", err)
+	}
+	b = decodeHex("02")
+	if _, rest, err := ReadString(b); !errors.Is(err, ErrShortBytes) || !bytes.Equal(rest, b) {
+		t.Errorf("ReadString(branch only): got %v, want ErrShortBytes", err)
+	}
+	if _, _, err := ReadBool(decodeHex("0202")); err == nil {
+		t.Error("ReadBool(2): expected error")
+	}
+}
+
+func assertValid(t *testing.T, valid, wantValid, valueOK bool, from string) {
+	t.Helper()
+	if valid != wantValid {
+		t.Errorf("%s: got valid %v, want %v", from, valid, wantValid)
+	}
+	if valid && !valueOK {
+		t.Errorf("%s: bad value", from)
+	}
+}
+
+func maybePanic(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
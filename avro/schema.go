@@ -0,0 +1,63 @@
+package avro
+
+import (
+	"fmt"
+
+	"github.com/vitdevelop/null"
+)
+
+const timestampMillis = `{"type":"long","logicalType":"timestamp-millis"}`
+
+// Schema returns the Avro schema of v, which must be one of the types of the null package or a pointer to one,
+// as JSON. The schema is a union with null as its first branch, such as ["null","long"] for null.Int64.
+func Schema(v any) (string, error) {
+	var typ string
+	switch v.(type) {
+	case null.Int64, *null.Int64:
+		typ = `"long"`
+	case null.Int32, *null.Int32:
+		typ = `"int"`
+	case null.Float, *null.Float:
+		typ = `"double"`
+	case null.Bool, *null.Bool, null.LenientBool, *null.LenientBool:
+		typ = `"boolean"`
+	case null.String, *null.String:
+		typ = `"string"`
+	case null.Time, *null.Time, null.Timestamp, *null.Timestamp:
+		typ = timestampMillis
+	default:
+		return "", fmt.Errorf("avro: no schema for %T", v)
+	}
+	return `["null",` + typ + `]`, nil
+}
+
+// Field returns the JSON of a record field named name, with the schema of v as its type and null as its default.
+// It returns an error if name is not a valid Avro name.
+//
+//	avro.Field("id", null.Int64{}) // {"name":"id","type":["null","long"],"default":null}
+func Field(name string, v any) (string, error) {
+	if !validName(name) {
+		return "", fmt.Errorf("avro: invalid field name %q", name)
+	}
+	schema, err := Schema(v)
+	if err != nil {
+		return "", err
+	}
+	return `{"name":"` + name + `","type":` + schema + `,"default":null}`, nil
+}
+
+// validName reports whether name matches [A-Za-z_][A-Za-z0-9_]*.
+func validName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i, c := range name {
+		switch {
+		case c == '_', c >= 'A' && c <= 'Z', c >= 'a' && c <= 'z':
+		case c >= '0' && c <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,59 @@
+package avro
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/vitdevelop/null"
+)
+
+func TestSchema(t *testing.T) {
+	tests := []struct {
+		v    any
+		want string
+	}{
+		{null.Int64{}, `["null","long"]`},
+		{&null.Int64{}, `["null","long"]`},
+		{null.Int32{}, `["null","int"]`},
+		{null.Float{}, `["null","double"]`},
+		{null.Bool{}, `["null","boolean"]`},
+		{null.LenientBool{}, `["null","boolean"]`},
+		{null.String{}, `["null","string"]`},
+		{null.Time{}, `["null",{"type":"long","logicalType":"timestamp-millis"}]`},
+		{&null.Timestamp{}, `["null",{"type":"long","logicalType":"timestamp-millis"}]`},
+	}
+	for _, test := range tests {
+		got, err := Schema(test.v)
+		maybePanic(err)
+		if got != test.want {
+			t.Errorf("Schema(%T): got %s, want %s", test.v, got, test.want)
+		}
+		if !json.Valid([]byte(got)) {
+			t.Errorf("Schema(%T): invalid JSON %s", test.v, got)
+		}
+	}
+
+	for _, v := range []any{int64(1), nil, null.Int64From} {
+		if _, err := Schema(v); err == nil {
+			t.Errorf("Schema(%T): expected error", v)
+		}
+	}
+}
+
+func TestField(t *testing.T) {
+	got, err := Field("created_at", null.Timestamp{})
+	maybePanic(err)
+	want := `{"name":"created_at","type":["null",{"type":"long","logicalType":"timestamp-millis"}],"default":null}`
+	if got != want {
+		t.Errorf("Field: got %s, want %s", got, want)
+	}
+
+	for _, name := range []string{"", "1st", "first-name", `a"b`, "naïve"} {
+		if _, err := Field(name, null.String{}); err == nil {
+			t.Errorf("Field(%q): expected error", name)
+		}
+	}
+	if _, err := Field("id", 1); err == nil {
+		t.Error("Field(int): expected error")
+	}
+}
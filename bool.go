@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"database/sql"
 	"encoding/json"
+	"encoding/xml"
 	"errors"
 	"fmt"
+	"strconv"
 )
 
 // Bool is a nullable bool.
@@ -43,6 +45,23 @@ func (b Bool) ValueOrZero() bool {
 	return b.Valid && b.Bool
 }
 
+// OrElse returns the inner value if valid, otherwise v.
+func (b Bool) OrElse(v bool) bool {
+	if !b.Valid {
+		return v
+	}
+	return b.Bool
+}
+
+// OrElseGet returns the inner value if valid, otherwise the result of calling f.
+// f is only called if this Bool is null.
+func (b Bool) OrElseGet(f func() bool) bool {
+	if !b.Valid {
+		return f()
+	}
+	return b.Bool
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 // It supports number and null input.
 // 0 will not be considered a null Bool.
@@ -52,6 +71,17 @@ func (b *Bool) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
+	switch string(data) {
+	case "true":
+		b.Bool = true
+		b.Valid = true
+		return nil
+	case "false":
+		b.Bool = false
+		b.Valid = true
+		return nil
+	}
+
 	if err := json.Unmarshal(data, &b.Bool); err != nil {
 		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
 	}
@@ -83,25 +113,118 @@ func (b *Bool) UnmarshalText(text []byte) error {
 // MarshalJSON implements json.Marshaler.
 // It will encode null if this Bool is null.
 func (b Bool) MarshalJSON() ([]byte, error) {
+	return b.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of b to dst, the same as MarshalJSON.
+func (b Bool) AppendJSON(dst []byte) ([]byte, error) {
 	if !b.Valid {
-		return []byte("null"), nil
-	}
-	if !b.Bool {
-		return []byte("false"), nil
+		return append(dst, nullBytes...), nil
 	}
-	return []byte("true"), nil
+	return strconv.AppendBool(dst, b.Bool), nil
 }
 
 // MarshalText implements encoding.TextMarshaler.
 // It will encode a blank string if this Bool is null.
 func (b Bool) MarshalText() ([]byte, error) {
+	return b.AppendText([]byte{})
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append nothing if this Bool is null.
+func (b Bool) AppendText(dst []byte) ([]byte, error) {
+	if !b.Valid {
+		return dst, nil
+	}
+	return strconv.AppendBool(dst, b.Bool), nil
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode null as an empty element; see XMLOmit and XMLNil for other encodings.
+func (b Bool) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, xmlNullEmpty, !b.Valid, b)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// It will unmarshal to a null Bool if the element is empty or has xsi:nil="true".
+func (b *Bool) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, b)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will encode null as an empty attribute; see XMLOmit and XMLNil to omit it.
+func (b Bool) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, xmlNullEmpty, !b.Valid, b)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+// It will unmarshal to a null Bool if the attribute is empty.
+func (b *Bool) UnmarshalXMLAttr(attr xml.Attr) error {
+	return b.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It encodes a version byte and a validity byte; valid values are followed by a byte that is 1 for true and 0 for false.
+func (b Bool) MarshalBinary() ([]byte, error) {
 	if !b.Valid {
-		return []byte{}, nil
+		return binaryNull(), nil
 	}
-	if !b.Bool {
-		return []byte("false"), nil
+	if b.Bool {
+		return append(binaryHeader(1), 1), nil
 	}
-	return []byte("true"), nil
+	return append(binaryHeader(1), 0), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// It decodes the encoding produced by MarshalBinary.
+func (b *Bool) UnmarshalBinary(data []byte) error {
+	value, valid, err := readBinary(data, 1)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		*b = NewBool(false, false)
+		return nil
+	}
+	if value[0] > 1 {
+		return fmt.Errorf("null: invalid binary bool %d", value[0])
+	}
+	*b = NewBool(value[0] == 1, true)
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using MarshalBinary.
+func (b Bool) GobEncode() ([]byte, error) {
+	return b.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using UnmarshalBinary.
+func (b *Bool) GobDecode(data []byte) error {
+	return b.UnmarshalBinary(data)
+}
+
+// MarshalYAML implements yaml.Marshaler from gopkg.in/yaml.v3 and compatible packages.
+// It will encode null if this Bool is null.
+func (b Bool) MarshalYAML() (any, error) {
+	if !b.Valid {
+		return nil, nil
+	}
+	return b.Bool, nil
+}
+
+// UnmarshalYAML implements the unmarshaler interface of gopkg.in/yaml.v2, which gopkg.in/yaml.v3 also supports.
+// It supports boolean and null input.
+func (b *Bool) UnmarshalYAML(unmarshal func(any) error) error {
+	var v *bool
+	if err := unmarshal(&v); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal YAML: %w", err)
+	}
+	if v == nil {
+		*b = NewBool(false, false)
+		return nil
+	}
+	*b = BoolFrom(*v)
+	return nil
 }
 
 // SetValid changes this Bool's value and also sets it to be non-null.
@@ -128,3 +251,77 @@ func (b Bool) IsZero() bool {
 func (b Bool) Equal(other Bool) bool {
 	return b.Valid == other.Valid && (!b.Valid || b.Bool == other.Bool)
 }
+
+// Compare returns an integer comparing two Bools, for use with slices.SortFunc and similar.
+// The result is -1 if b < other, 0 if b == other, and +1 if b > other.
+// false is considered less than true.
+// Null is considered less than any valid value; use NullsLast for the opposite order.
+func (b Bool) Compare(other Bool) int {
+	if !b.Valid || !other.Valid {
+		return compareBool(b.Valid, other.Valid)
+	}
+	return compareBool(b.Bool, other.Bool)
+}
+
+// IsTrue returns true if this Bool is valid and true, like SQL's IS TRUE.
+func (b Bool) IsTrue() bool {
+	return b.Valid && b.Bool
+}
+
+// IsFalse returns true if this Bool is valid and false, like SQL's IS FALSE.
+func (b Bool) IsFalse() bool {
+	return b.Valid && !b.Bool
+}
+
+// IsUnknown returns true if this Bool is null, like SQL's IS UNKNOWN.
+func (b Bool) IsUnknown() bool {
+	return !b.Valid
+}
+
+// Not returns the negation of this Bool using SQL three-valued logic.
+// NOT null is null.
+func (b Bool) Not() Bool {
+	if !b.Valid {
+		return NewBool(false, false)
+	}
+	return BoolFrom(!b.Bool)
+}
+
+// And returns the conjunction of both Bools using SQL three-valued logic.
+// false AND null is false, true AND null is null.
+func (b Bool) And(other Bool) Bool {
+	if b.IsFalse() || other.IsFalse() {
+		return BoolFrom(false)
+	}
+	if !b.Valid || !other.Valid {
+		return NewBool(false, false)
+	}
+	return BoolFrom(true)
+}
+
+// Or returns the disjunction of both Bools using SQL three-valued logic.
+// true OR null is true, false OR null is null.
+func (b Bool) Or(other Bool) Bool {
+	if b.IsTrue() || other.IsTrue() {
+		return BoolFrom(true)
+	}
+	if !b.Valid || !other.Valid {
+		return NewBool(false, false)
+	}
+	return BoolFrom(false)
+}
+
+// Xor returns the exclusive disjunction of both Bools using SQL three-valued logic.
+// It is null if either Bool is null.
+func (b Bool) Xor(other Bool) Bool {
+	if !b.Valid || !other.Valid {
+		return NewBool(false, false)
+	}
+	return BoolFrom(b.Bool != other.Bool)
+}
+
+// Implies returns the material implication of both Bools using SQL three-valued logic,
+// equivalent to NOT b OR other.
+func (b Bool) Implies(other Bool) Bool {
+	return b.Not().Or(other)
+}
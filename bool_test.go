@@ -216,6 +216,62 @@ func TestBoolEqual(t *testing.T) {
 	assertBoolEqualIsFalse(t, b1, b2)
 }
 
+func TestBoolThreeValuedLogic(t *testing.T) {
+	tr, fa, nu := BoolFrom(true), BoolFrom(false), NewBool(false, false)
+
+	tests := []struct {
+		name string
+		got  Bool
+		want Bool
+	}{
+		{"NOT true", tr.Not(), fa},
+		{"NOT false", fa.Not(), tr},
+		{"NOT null", nu.Not(), nu},
+
+		{"true AND true", tr.And(tr), tr},
+		{"true AND false", tr.And(fa), fa},
+		{"true AND null", tr.And(nu), nu},
+		{"false AND null", fa.And(nu), fa},
+		{"null AND false", nu.And(fa), fa},
+		{"null AND null", nu.And(nu), nu},
+
+		{"false OR false", fa.Or(fa), fa},
+		{"true OR false", tr.Or(fa), tr},
+		{"true OR null", tr.Or(nu), tr},
+		{"null OR true", nu.Or(tr), tr},
+		{"false OR null", fa.Or(nu), nu},
+		{"null OR null", nu.Or(nu), nu},
+
+		{"true XOR false", tr.Xor(fa), tr},
+		{"true XOR true", tr.Xor(tr), fa},
+		{"true XOR null", tr.Xor(nu), nu},
+
+		{"true IMPLIES false", tr.Implies(fa), fa},
+		{"false IMPLIES null", fa.Implies(nu), tr},
+		{"null IMPLIES true", nu.Implies(tr), tr},
+		{"true IMPLIES null", tr.Implies(nu), nu},
+	}
+	for _, test := range tests {
+		if !test.got.Equal(test.want) {
+			t.Errorf("%s: got Bool{%t, Valid:%t}, want Bool{%t, Valid:%t}",
+				test.name, test.got.Bool, test.got.Valid, test.want.Bool, test.want.Valid)
+		}
+	}
+}
+
+func TestBoolIsTrueFalseUnknown(t *testing.T) {
+	tr, fa, nu := BoolFrom(true), BoolFrom(false), NewBool(false, false)
+	if !tr.IsTrue() || tr.IsFalse() || tr.IsUnknown() {
+		t.Error("true should only be IS TRUE")
+	}
+	if fa.IsTrue() || !fa.IsFalse() || fa.IsUnknown() {
+		t.Error("false should only be IS FALSE")
+	}
+	if nu.IsTrue() || nu.IsFalse() || !nu.IsUnknown() {
+		t.Error("null should only be IS UNKNOWN")
+	}
+}
+
 func assertBool(t *testing.T, b Bool, from string) {
 	if b.Bool != true {
 		t.Errorf("bad %s bool: %v ≠ %v\n", from, b.Bool, true)
@@ -0,0 +1,412 @@
+// Package cbor encodes and decodes the types of github.com/vitdevelop/null and its zero package
+// in CBOR format (RFC 8949), without reflection or dependencies.
+//
+// For every type there is an Append function, which appends a value to a byte slice,
+// and a Read function, which reads a value from the start of a byte slice and returns the remaining bytes:
+//
+//	b = cbor.AppendInt64(b, id)
+//	b = cbor.AppendZeroString(b, name)
+//	...
+//	id, b, err = cbor.ReadInt64(b)
+//	name, b, err = cbor.ReadZeroString(b)
+//
+// Null values of the null package are encoded as null (simple value 22), and valid values as:
+// unsigned or negative integers for Int64 and Int32, double-precision floats for Float,
+// booleans for Bool and LenientBool, text strings for String,
+// tag 0 RFC 3339 text strings for Time, and tag 1 epoch-based numbers for Timestamp.
+// Null values of the zero package are encoded as their zero value.
+//
+// Decoding also accepts undefined (simple value 23) as null, and converts between some types:
+// Float accepts integers and half and single-precision floats, String accepts byte strings,
+// and Time and Timestamp accept both tag 0 and tag 1. Other mismatched types return an error wrapping ErrType.
+// Epoch-based times with a fractional part are decoded with millisecond precision.
+package cbor
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+	"unicode/utf8"
+)
+
+var (
+	// ErrShortBytes is returned when the input ends in the middle of a value.
+	ErrShortBytes = errors.New("cbor: too few bytes left to read value")
+	// ErrOverflow is returned when an integer doesn't fit the type it is read into.
+	ErrOverflow = errors.New("cbor: integer overflow")
+	// ErrType is returned, wrapped, when the input holds a value of an unexpected type.
+	ErrType = errors.New("cbor: unexpected type")
+)
+
+// major types
+const (
+	majorUint   = 0
+	majorNegInt = 1
+	majorBytes  = 2
+	majorText   = 3
+	majorArray  = 4
+	majorMap    = 5
+	majorTag    = 6
+	majorSimple = 7
+)
+
+// initial bytes of simple values and floats
+const (
+	simpleFalse     = 0xf4
+	simpleTrue      = 0xf5
+	simpleNull      = 0xf6
+	simpleUndefined = 0xf7
+	floatHalf       = 0xf9
+	floatSingle     = 0xfa
+	floatDouble     = 0xfb
+	breakCode       = 0xff
+)
+
+// tags for times
+const (
+	tagDateTime = 0
+	tagEpoch    = 1
+)
+
+// describe names the type of the value starting with c, for errors.
+func describe(c byte) string {
+	switch c >> 5 {
+	case majorUint:
+		return "unsigned integer"
+	case majorNegInt:
+		return "negative integer"
+	case majorBytes:
+		return "byte string"
+	case majorText:
+		return "text string"
+	case majorArray:
+		return "array"
+	case majorMap:
+		return "map"
+	case majorTag:
+		return "tag"
+	}
+	switch c {
+	case simpleFalse, simpleTrue:
+		return "bool"
+	case simpleNull:
+		return "null"
+	case simpleUndefined:
+		return "undefined"
+	case floatHalf, floatSingle, floatDouble:
+		return "float"
+	case breakCode:
+		return "break"
+	}
+	return "simple value"
+}
+
+// typeError returns an error for a value starting with c where a value of type want was expected.
+func typeError(c byte, want string) error {
+	return fmt.Errorf("%w: got %s, need %s", ErrType, describe(c), want)
+}
+
+// need returns ErrShortBytes if b has less than n bytes.
+func need(b []byte, n int) error {
+	if len(b) < n {
+		return ErrShortBytes
+	}
+	return nil
+}
+
+// appendHead appends the initial byte of a value of the major type and its argument n,
+// using the shortest encoding.
+func appendHead(b []byte, major byte, n uint64) []byte {
+	m := major << 5
+	switch {
+	case n < 24:
+		return append(b, m|byte(n))
+	case n <= math.MaxUint8:
+		return append(b, m|24, byte(n))
+	case n <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(b, m|25), uint16(n))
+	case n <= math.MaxUint32:
+		return binary.BigEndian.AppendUint32(append(b, m|26), uint32(n))
+	}
+	return binary.BigEndian.AppendUint64(append(b, m|27), n)
+}
+
+func appendNull(b []byte) []byte {
+	return append(b, simpleNull)
+}
+
+func appendBool(b []byte, v bool) []byte {
+	if v {
+		return append(b, simpleTrue)
+	}
+	return append(b, simpleFalse)
+}
+
+func appendInt(b []byte, v int64) []byte {
+	if v < 0 {
+		return appendHead(b, majorNegInt, uint64(-1-v))
+	}
+	return appendHead(b, majorUint, uint64(v))
+}
+
+func appendFloat(b []byte, f float64) []byte {
+	return binary.BigEndian.AppendUint64(append(b, floatDouble), math.Float64bits(f))
+}
+
+func appendText(b []byte, s string) []byte {
+	return append(appendHead(b, majorText, uint64(len(s))), s...)
+}
+
+// appendDateTime appends t as a tag 0 RFC 3339 text string.
+func appendDateTime(b []byte, t time.Time) []byte {
+	return appendText(appendHead(b, majorTag, tagDateTime), t.Format(time.RFC3339Nano))
+}
+
+// appendEpoch appends t as a tag 1 number of seconds since the epoch,
+// which is an integer for whole seconds and a float otherwise.
+func appendEpoch(b []byte, t time.Time) []byte {
+	b = appendHead(b, majorTag, tagEpoch)
+	if t.Nanosecond() == 0 {
+		return appendInt(b, t.Unix())
+	}
+	return appendFloat(b, float64(t.Unix())+float64(t.Nanosecond())/float64(time.Second))
+}
+
+// readHead reads the initial byte of a value and its argument.
+// For indefinite-length values, indefinite is true and n is 0.
+func readHead(b []byte) (major byte, n uint64, indefinite bool, rest []byte, err error) {
+	if err := need(b, 1); err != nil {
+		return 0, 0, false, b, err
+	}
+	major, info := b[0]>>5, b[0]&0x1f
+	switch {
+	case info < 24:
+		return major, uint64(info), false, b[1:], nil
+	case info == 31:
+		return major, 0, true, b[1:], nil
+	case info > 27:
+		return 0, 0, false, b, fmt.Errorf("cbor: invalid additional information %d", info)
+	}
+	size := 1 << (info - 24)
+	if err := need(b, 1+size); err != nil {
+		return 0, 0, false, b, err
+	}
+	switch size {
+	case 1:
+		n = uint64(b[1])
+	case 2:
+		n = uint64(binary.BigEndian.Uint16(b[1:]))
+	case 4:
+		n = uint64(binary.BigEndian.Uint32(b[1:]))
+	default:
+		n = binary.BigEndian.Uint64(b[1:])
+	}
+	return major, n, false, b[1+size:], nil
+}
+
+// readNull returns the bytes after a null or undefined at the start of b,
+// or false if b doesn't start with either.
+func readNull(b []byte) ([]byte, bool) {
+	if len(b) == 0 || (b[0] != simpleNull && b[0] != simpleUndefined) {
+		return b, false
+	}
+	return b[1:], true
+}
+
+func readBool(b []byte) (bool, []byte, error) {
+	if err := need(b, 1); err != nil {
+		return false, b, err
+	}
+	switch b[0] {
+	case simpleFalse:
+		return false, b[1:], nil
+	case simpleTrue:
+		return true, b[1:], nil
+	}
+	return false, b, typeError(b[0], "bool")
+}
+
+// readInt reads an unsigned or negative integer, returning ErrOverflow if it doesn't fit in an int64.
+func readInt(b []byte) (int64, []byte, error) {
+	if err := need(b, 1); err != nil {
+		return 0, b, err
+	}
+	if major := b[0] >> 5; major != majorUint && major != majorNegInt {
+		return 0, b, typeError(b[0], "integer")
+	}
+	major, n, indefinite, rest, err := readHead(b)
+	if err != nil {
+		return 0, b, err
+	}
+	if indefinite {
+		return 0, b, fmt.Errorf("cbor: invalid indefinite-length integer")
+	}
+	if n > math.MaxInt64 {
+		return 0, b, ErrOverflow
+	}
+	if major == majorNegInt {
+		return -1 - int64(n), rest, nil
+	}
+	return int64(n), rest, nil
+}
+
+// readFloat reads a half, single or double-precision float, or an integer.
+func readFloat(b []byte) (float64, []byte, error) {
+	if err := need(b, 1); err != nil {
+		return 0, b, err
+	}
+	switch b[0] >> 5 {
+	case majorUint, majorNegInt:
+		major, n, indefinite, rest, err := readHead(b)
+		if err != nil {
+			return 0, b, err
+		}
+		if indefinite {
+			return 0, b, fmt.Errorf("cbor: invalid indefinite-length integer")
+		}
+		if major == majorNegInt {
+			return -1 - float64(n), rest, nil
+		}
+		return float64(n), rest, nil
+	}
+
+	switch b[0] {
+	case floatHalf, floatSingle, floatDouble:
+	default:
+		return 0, b, typeError(b[0], "float or integer")
+	}
+	_, bits, _, rest, err := readHead(b)
+	if err != nil {
+		return 0, b, err
+	}
+	switch b[0] {
+	case floatHalf:
+		return float16(uint16(bits)), rest, nil
+	case floatSingle:
+		return float64(math.Float32frombits(uint32(bits))), rest, nil
+	}
+	return math.Float64frombits(bits), rest, nil
+}
+
+// float16 converts the bits of a half-precision float.
+func float16(h uint16) float64 {
+	exp, mant := int(h>>10)&0x1f, float64(h&0x3ff)
+	var f float64
+	switch exp {
+	case 0:
+		f = math.Ldexp(mant, -24)
+	case 31:
+		if mant != 0 {
+			return math.NaN()
+		}
+		f = math.Inf(1)
+	default:
+		f = math.Ldexp(mant+1024, exp-25)
+	}
+	if h&0x8000 != 0 {
+		return -f
+	}
+	return f
+}
+
+// readText reads a text or byte string, including indefinite-length strings made of chunks.
+// Text strings must be valid UTF-8; byte strings are returned as is.
+func readText(b []byte) (string, []byte, error) {
+	if err := need(b, 1); err != nil {
+		return "", b, err
+	}
+	major, n, indefinite, rest, err := readHead(b)
+	if err != nil {
+		return "", b, err
+	}
+	if major != majorText && major != majorBytes {
+		return "", b, typeError(b[0], "text or byte string")
+	}
+	if !indefinite {
+		if uint64(len(rest)) < n {
+			return "", b, ErrShortBytes
+		}
+		if major == majorText && !utf8.Valid(rest[:n]) {
+			return "", b, fmt.Errorf("cbor: invalid UTF-8 in text string")
+		}
+		return string(rest[:n]), rest[n:], nil
+	}
+
+	var s []byte
+	for {
+		if err := need(rest, 1); err != nil {
+			return "", b, err
+		}
+		if rest[0] == breakCode {
+			return string(s), rest[1:], nil
+		}
+		chunkMajor, n, chunkIndefinite, chunkRest, err := readHead(rest)
+		if err != nil {
+			return "", b, err
+		}
+		if chunkMajor != major || chunkIndefinite {
+			return "", b, fmt.Errorf("cbor: invalid chunk in indefinite-length %s: %s", describe(b[0]), describe(rest[0]))
+		}
+		if uint64(len(chunkRest)) < n {
+			return "", b, ErrShortBytes
+		}
+		// each chunk of a text string must be valid UTF-8 on its own
+		if major == majorText && !utf8.Valid(chunkRest[:n]) {
+			return "", b, fmt.Errorf("cbor: invalid UTF-8 in text string")
+		}
+		s = append(s, chunkRest[:n]...)
+		rest = chunkRest[n:]
+	}
+}
+
+// readTime reads a tag 0 RFC 3339 text string or a tag 1 epoch-based number.
+func readTime(b []byte) (time.Time, []byte, error) {
+	if err := need(b, 1); err != nil {
+		return time.Time{}, b, err
+	}
+	if b[0]>>5 != majorTag {
+		return time.Time{}, b, typeError(b[0], "tag 0 or 1")
+	}
+	_, tag, indefinite, rest, err := readHead(b)
+	if err != nil {
+		return time.Time{}, b, err
+	}
+	if indefinite {
+		return time.Time{}, b, fmt.Errorf("cbor: invalid additional information 31 in tag")
+	}
+
+	switch tag {
+	case tagDateTime:
+		if len(rest) > 0 && rest[0]>>5 != majorText {
+			return time.Time{}, b, typeError(rest[0], "text string in tag 0")
+		}
+		s, rest, err := readText(rest)
+		if err != nil {
+			return time.Time{}, b, err
+		}
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return time.Time{}, b, fmt.Errorf("cbor: invalid tag 0 date/time: %w", err)
+		}
+		return t, rest, nil
+	case tagEpoch:
+		if len(rest) > 0 && (rest[0]>>5 == majorUint || rest[0]>>5 == majorNegInt) {
+			sec, rest, err := readInt(rest)
+			if err != nil {
+				return time.Time{}, b, err
+			}
+			return time.Unix(sec, 0).UTC(), rest, nil
+		}
+		f, rest, err := readFloat(rest)
+		if err != nil {
+			return time.Time{}, b, err
+		}
+		if math.IsNaN(f) || math.Abs(f*1000) >= math.MaxInt64 {
+			return time.Time{}, b, fmt.Errorf("cbor: invalid tag 1 epoch time %v", f)
+		}
+		return time.UnixMilli(int64(math.Round(f * 1000))).UTC(), rest, nil
+	}
+	return time.Time{}, b, fmt.Errorf("%w: got tag %d, need tag 0 or 1", ErrType, tag)
+}
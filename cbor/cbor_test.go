@@ -0,0 +1,290 @@
+package cbor
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+// The encoded values below are taken from the examples in RFC 8949, Appendix A.
+
+func TestAppendInt(t *testing.T) {
+	tests := []struct {
+		v    int64
+		want string
+	}{
+		{0, "00"},
+		{23, "17"},
+		{24, "1818"},
+		{100, "1864"},
+		{1000, "1903e8"},
+		{1000000, "1a000f4240"},
+		{1000000000000, "1b000000e8d4a51000"},
+		{math.MaxInt64, "1b7fffffffffffffff"},
+		{-1, "20"},
+		{-10, "29"},
+		{-100, "3863"},
+		{-1000, "3903e7"},
+		{math.MinInt64, "3b7fffffffffffffff"},
+	}
+	for _, test := range tests {
+		b := appendInt(nil, test.v)
+		if got := hex.EncodeToString(b); got != test.want {
+			t.Errorf("appendInt(%d): got %s, want %s", test.v, got, test.want)
+		}
+		n, rest, err := readInt(append(b, simpleNull))
+		if err != nil || n != test.v || !bytes.Equal(rest, []byte{simpleNull}) {
+			t.Errorf("readInt(% x): got %d, % x, %v", b, n, rest, err)
+		}
+	}
+}
+
+func TestReadInt(t *testing.T) {
+	// non-shortest encodings of small values, which other encoders may produce
+	for _, s := range []string{"1805", "190005", "1a00000005", "1b0000000000000005"} {
+		n, _, err := readInt(decodeHex(s))
+		if err != nil || n != 5 {
+			t.Errorf("readInt(%s): got %d, %v", s, n, err)
+		}
+	}
+
+	for _, s := range []string{"1bffffffffffffffff", "3b8000000000000000"} {
+		if _, _, err := readInt(decodeHex(s)); !errors.Is(err, ErrOverflow) {
+			t.Errorf("readInt(%s): got %v, want ErrOverflow", s, err)
+		}
+	}
+}
+
+func TestFloat(t *testing.T) {
+	b := appendFloat(nil, 1.1)
+	if got, want := hex.EncodeToString(b), "fb3ff199999999999a"; got != want {
+		t.Errorf("appendFloat(1.1): got %s, want %s", got, want)
+	}
+
+	tests := []struct {
+		s    string
+		want float64
+	}{
+		{"fb3ff199999999999a", 1.1},
+		{"f93c00", 1.0},
+		{"f97bff", 65504.0},
+		{"f90001", 5.960464477539063e-8},
+		{"f90400", 0.00006103515625},
+		{"f9c400", -4.0},
+		{"f98000", math.Copysign(0, -1)},
+		{"f97c00", math.Inf(1)},
+		{"f9fc00", math.Inf(-1)},
+		{"fa47c35000", 100000.0},
+		{"fa7f7fffff", 3.4028234663852886e+38},
+		{"fbc010666666666666", -4.1},
+		{"1864", 100},
+		{"3863", -100},
+		{"1bffffffffffffffff", math.MaxUint64},
+	}
+	for _, test := range tests {
+		f, rest, err := readFloat(decodeHex(test.s))
+		if err != nil || f != test.want || math.Signbit(f) != math.Signbit(test.want) || len(rest) != 0 {
+			t.Errorf("readFloat(%s): got %v, % x, %v", test.s, f, rest, err)
+		}
+	}
+
+	for _, s := range []string{"f97e00", "fa7fc00000", "fb7ff8000000000000"} {
+		if f, _, err := readFloat(decodeHex(s)); err != nil || !math.IsNaN(f) {
+			t.Errorf("readFloat(%s): got %v, %v, want NaN", s, f, err)
+		}
+	}
+}
+
+func TestText(t *testing.T) {
+	tests := []struct {
+		n      int
+		header string
+	}{
+		{0, "60"},
+		{23, "77"},
+		{24, "7818"},
+		{255, "78ff"},
+		{256, "790100"},
+		{65536, "7a00010000"},
+	}
+	for _, test := range tests {
+		s := strings.Repeat("a", test.n)
+		b := appendText(nil, s)
+		if got := hex.EncodeToString(b[:len(b)-test.n]); got != test.header {
+			t.Errorf("appendText(%d bytes): got header %s, want %s", test.n, got, test.header)
+		}
+		got, rest, err := readText(b)
+		if err != nil || got != s || len(rest) != 0 {
+			t.Errorf("readText(%d bytes): got %d bytes, % x, %v", test.n, len(got), rest, err)
+		}
+	}
+
+	reads := []struct {
+		s    string
+		want string
+	}{
+		{"6449455446", "IETF"},
+		{"62c3bc", "ü"},
+		{"4401020304", "\x01\x02\x03\x04"},
+		{"7f657374726561646d696e67ff", "streaming"},
+		{"5f42010243030405ff", "\x01\x02\x03\x04\x05"},
+		{"7fff", ""},
+	}
+	for _, test := range reads {
+		got, rest, err := readText(decodeHex(test.s))
+		if err != nil || got != test.want || len(rest) != 0 {
+			t.Errorf("readText(%s): got %q, % x, %v", test.s, got, rest, err)
+		}
+	}
+}
+
+func TestTime(t *testing.T) {
+	rfc := time.Date(2013, 3, 21, 20, 4, 0, 0, time.UTC)
+
+	b := appendDateTime(nil, rfc)
+	if got, want := hex.EncodeToString(b), "c074323031332d30332d32315432303a30343a30305a"; got != want {
+		t.Errorf("appendDateTime(%v): got %s, want %s", rfc, got, want)
+	}
+	b = appendEpoch(nil, rfc)
+	if got, want := hex.EncodeToString(b), "c11a514b67b0"; got != want {
+		t.Errorf("appendEpoch(%v): got %s, want %s", rfc, got, want)
+	}
+	half := rfc.Add(500 * time.Millisecond)
+	b = appendEpoch(nil, half)
+	if got, want := hex.EncodeToString(b), "c1fb41d452d9ec200000"; got != want {
+		t.Errorf("appendEpoch(%v): got %s, want %s", half, got, want)
+	}
+
+	tests := []struct {
+		s    string
+		want time.Time
+	}{
+		{"c074323031332d30332d32315432303a30343a30305a", rfc},
+		{"c11a514b67b0", rfc},
+		{"c1fb41d452d9ec200000", half},
+		{"c120", time.Unix(-1, 0)},
+		{"c1f93e00", time.Unix(1, 500*int64(time.Millisecond))},
+	}
+	for _, test := range tests {
+		got, rest, err := readTime(decodeHex(test.s))
+		if err != nil || !got.Equal(test.want) || len(rest) != 0 {
+			t.Errorf("readTime(%s): got %v, % x, %v", test.s, got, rest, err)
+		}
+	}
+
+	// tag 0 keeps the time zone offset, tag 1 is read in UTC
+	local := time.Date(2012, 12, 21, 22, 21, 21, 123456789, time.FixedZone("", 3600))
+	got, _, err := readTime(appendDateTime(nil, local))
+	if _, offset := got.Zone(); err != nil || !got.Equal(local) || offset != 3600 {
+		t.Errorf("tag 0 round trip: got %v, %v", got, err)
+	}
+	got, _, err = readTime(appendEpoch(nil, local))
+	if err != nil || !got.Equal(local.Truncate(time.Millisecond)) || got.Location() != time.UTC {
+		t.Errorf("tag 1 round trip: got %v, %v", got, err)
+	}
+
+	// zero time.Time is far before the epoch
+	for _, b := range [][]byte{appendDateTime(nil, time.Time{}), appendEpoch(nil, time.Time{})} {
+		zero, _, err := readTime(b)
+		if err != nil || !zero.IsZero() {
+			t.Errorf("zero time % x: got %v, %v", b, zero, err)
+		}
+	}
+}
+
+func TestReadErrors(t *testing.T) {
+	short := []struct {
+		name string
+		read func([]byte) error
+		s    string
+	}{
+		{"int", readErr(readInt), "1901"},
+		{"float", readErr(readFloat), "fb01"},
+		{"half float", readErr(readFloat), "f93c"},
+		{"bool", readErr(readBool), ""},
+		{"text", readErr(readText), "636869"},
+		{"text head", readErr(readText), "78"},
+		{"indefinite text", readErr(readText), "7f6268"},
+		{"unterminated text", readErr(readText), "7f626869"},
+		{"time", readErr(readTime), "c1"},
+		{"time head", readErr(readTime), "d8"},
+	}
+	for _, test := range short {
+		if err := test.read(decodeHex(test.s)); !errors.Is(err, ErrShortBytes) {
+			t.Errorf("%s(%s): got %v, want ErrShortBytes", test.name, test.s, err)
+		}
+	}
+
+	wrongType := []struct {
+		name string
+		read func([]byte) error
+		s    string
+	}{
+		{"int", readErr(readInt), "f5"},
+		{"int from float", readErr(readInt), "f93c00"},
+		{"float", readErr(readFloat), "60"},
+		{"bool", readErr(readBool), "01"},
+		{"bool from null", readErr(readBool), "f6"},
+		{"text", readErr(readText), "80"},
+		{"time", readErr(readTime), "01"},
+		{"time tag", readErr(readTime), "c21a514b67b0"},
+		{"tag 0 content", readErr(readTime), "c01a514b67b0"},
+		{"tag 1 content", readErr(readTime), "c16161"},
+	}
+	for _, test := range wrongType {
+		if err := test.read(decodeHex(test.s)); !errors.Is(err, ErrType) {
+			t.Errorf("%s(%s): got %v, want ErrType", test.name, test.s, err)
+		}
+	}
+
+	invalid := []struct {
+		name string
+		read func([]byte) error
+		s    string
+	}{
+		{"reserved additional information", readErr(readInt), "1c"},
+		{"indefinite int", readErr(readInt), "1f"},
+		{"mixed chunks", readErr(readText), "7f4161ff"},
+		{"nested indefinite chunk", readErr(readText), "7f7fffff"},
+		{"invalid UTF-8 text", readErr(readText), "62c328"},
+		{"invalid UTF-8 text chunk", readErr(readText), "7f61c36128ff"},
+		{"indefinite tag", readErr(readTime), "df1a514b67b0"},
+		{"bad date/time", readErr(readTime), "c06568656c6c6f"},
+		{"NaN epoch", readErr(readTime), "c1f97e00"},
+		{"infinite epoch", readErr(readTime), "c1f97c00"},
+	}
+	for _, test := range invalid {
+		if err := test.read(decodeHex(test.s)); err == nil || errors.Is(err, ErrShortBytes) || errors.Is(err, ErrType) {
+			t.Errorf("%s(%s): got %v, want invalid input error", test.name, test.s, err)
+		}
+	}
+}
+
+func TestTypeError(t *testing.T) {
+	_, _, err := readInt([]byte{0x80})
+	if got, want := err.Error(), "cbor: unexpected type: got array, need integer"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func readErr[T any](read func([]byte) (T, []byte, error)) func([]byte) error {
+	return func(b []byte) error {
+		_, rest, err := read(b)
+		if err != nil && !bytes.Equal(rest, b) {
+			return errors.New("input not returned on error")
+		}
+		return err
+	}
+}
+
+func decodeHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
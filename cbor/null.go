@@ -0,0 +1,194 @@
+package cbor
+
+import (
+	"math"
+	"time"
+
+	"github.com/vitdevelop/null"
+)
+
+// AppendInt64 appends v to b as an integer, or null if v is null.
+func AppendInt64(b []byte, v null.Int64) []byte {
+	if !v.Valid {
+		return appendNull(b)
+	}
+	return appendInt(b, v.Int64)
+}
+
+// ReadInt64 reads an integer or null from the start of b, returning the remaining bytes.
+func ReadInt64(b []byte) (null.Int64, []byte, error) {
+	if rest, ok := readNull(b); ok {
+		return null.NewInt64(0, false), rest, nil
+	}
+	n, rest, err := readInt(b)
+	if err != nil {
+		return null.Int64{}, b, err
+	}
+	return null.Int64From(n), rest, nil
+}
+
+// AppendInt32 appends v to b as an integer, or null if v is null.
+func AppendInt32(b []byte, v null.Int32) []byte {
+	if !v.Valid {
+		return appendNull(b)
+	}
+	return appendInt(b, int64(v.Int32))
+}
+
+// ReadInt32 reads an integer or null from the start of b, returning the remaining bytes.
+// It returns ErrOverflow if the integer doesn't fit in an int32.
+func ReadInt32(b []byte) (null.Int32, []byte, error) {
+	if rest, ok := readNull(b); ok {
+		return null.NewInt32(0, false), rest, nil
+	}
+	n, rest, err := readInt(b)
+	if err != nil {
+		return null.Int32{}, b, err
+	}
+	if n < math.MinInt32 || n > math.MaxInt32 {
+		return null.Int32{}, b, ErrOverflow
+	}
+	return null.Int32From(int32(n)), rest, nil
+}
+
+// AppendFloat appends v to b as a double-precision float, or null if v is null.
+func AppendFloat(b []byte, v null.Float) []byte {
+	if !v.Valid {
+		return appendNull(b)
+	}
+	return appendFloat(b, v.Float64)
+}
+
+// ReadFloat reads a float, integer or null from the start of b, returning the remaining bytes.
+func ReadFloat(b []byte) (null.Float, []byte, error) {
+	if rest, ok := readNull(b); ok {
+		return null.NewFloat(0, false), rest, nil
+	}
+	f, rest, err := readFloat(b)
+	if err != nil {
+		return null.Float{}, b, err
+	}
+	return null.FloatFrom(f), rest, nil
+}
+
+// AppendBool appends v to b as a bool, or null if v is null.
+func AppendBool(b []byte, v null.Bool) []byte {
+	if !v.Valid {
+		return appendNull(b)
+	}
+	return appendBool(b, v.Bool)
+}
+
+// ReadBool reads a bool or null from the start of b, returning the remaining bytes.
+func ReadBool(b []byte) (null.Bool, []byte, error) {
+	if rest, ok := readNull(b); ok {
+		return null.NewBool(false, false), rest, nil
+	}
+	v, rest, err := readBool(b)
+	if err != nil {
+		return null.Bool{}, b, err
+	}
+	return null.BoolFrom(v), rest, nil
+}
+
+// AppendLenientBool appends v to b as a bool, or null if v is null.
+func AppendLenientBool(b []byte, v null.LenientBool) []byte {
+	return AppendBool(b, v.Bool)
+}
+
+// ReadLenientBool reads a bool, the integers 0 or 1, a text string accepted by LenientBool.UnmarshalText,
+// or null from the start of b, returning the remaining bytes.
+func ReadLenientBool(b []byte) (null.LenientBool, []byte, error) {
+	if err := need(b, 1); err != nil {
+		return null.LenientBool{}, b, err
+	}
+	switch b[0] >> 5 {
+	case majorUint, majorNegInt:
+		n, rest, err := readInt(b)
+		if err != nil {
+			return null.LenientBool{}, b, err
+		}
+		if n != 0 && n != 1 {
+			return null.LenientBool{}, b, typeError(b[0], "bool, 0 or 1")
+		}
+		return null.LenientBoolFrom(n == 1), rest, nil
+	case majorText:
+		s, rest, err := readText(b)
+		if err != nil {
+			return null.LenientBool{}, b, err
+		}
+		var v null.LenientBool
+		if err := v.UnmarshalText([]byte(s)); err != nil {
+			return null.LenientBool{}, b, err
+		}
+		return v, rest, nil
+	}
+	v, rest, err := ReadBool(b)
+	if err != nil {
+		return null.LenientBool{}, b, err
+	}
+	return null.LenientBool{Bool: v}, rest, nil
+}
+
+// AppendString appends v to b as a text string, or null if v is null.
+func AppendString(b []byte, v null.String) []byte {
+	if !v.Valid {
+		return appendNull(b)
+	}
+	return appendText(b, v.String)
+}
+
+// ReadString reads a text string, byte string or null from the start of b, returning the remaining bytes.
+func ReadString(b []byte) (null.String, []byte, error) {
+	if rest, ok := readNull(b); ok {
+		return null.NewString("", false), rest, nil
+	}
+	s, rest, err := readText(b)
+	if err != nil {
+		return null.String{}, b, err
+	}
+	return null.StringFrom(s), rest, nil
+}
+
+// AppendTime appends v to b as a tag 0 RFC 3339 text string, or null if v is null.
+func AppendTime(b []byte, v null.Time) []byte {
+	if !v.Valid {
+		return appendNull(b)
+	}
+	return appendDateTime(b, v.Time)
+}
+
+// ReadTime reads a tag 0 date/time string, a tag 1 epoch-based time or null from the start of b,
+// returning the remaining bytes.
+func ReadTime(b []byte) (null.Time, []byte, error) {
+	if rest, ok := readNull(b); ok {
+		return null.NewTime(time.Time{}, false), rest, nil
+	}
+	t, rest, err := readTime(b)
+	if err != nil {
+		return null.Time{}, b, err
+	}
+	return null.TimeFrom(t), rest, nil
+}
+
+// AppendTimestamp appends v to b as a tag 1 epoch-based time, or null if v is null.
+// Whole seconds are encoded as an integer, other times as a float.
+func AppendTimestamp(b []byte, v null.Timestamp) []byte {
+	if !v.Valid {
+		return appendNull(b)
+	}
+	return appendEpoch(b, v.Time)
+}
+
+// ReadTimestamp reads a tag 1 epoch-based time, a tag 0 date/time string or null from the start of b,
+// returning the remaining bytes.
+func ReadTimestamp(b []byte) (null.Timestamp, []byte, error) {
+	if rest, ok := readNull(b); ok {
+		return null.NewTimestamp(time.Time{}, false), rest, nil
+	}
+	t, rest, err := readTime(b)
+	if err != nil {
+		return null.Timestamp{}, b, err
+	}
+	return null.TimestampFrom(t), rest, nil
+}
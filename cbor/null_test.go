@@ -0,0 +1,187 @@
+package cbor
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/vitdevelop/null"
+)
+
+func TestNullRoundTrip(t *testing.T) {
+	created := time.Date(2012, 12, 21, 21, 21, 21, 123000000, time.UTC)
+	for _, valid := range []bool{true, false} {
+		var b []byte
+		b = AppendInt64(b, null.NewInt64(math.MinInt64, valid))
+		b = AppendInt32(b, null.NewInt32(math.MaxInt32, valid))
+		b = AppendFloat(b, null.NewFloat(1.2345, valid))
+		b = AppendBool(b, null.NewBool(false, valid))
+		b = AppendLenientBool(b, null.NewLenientBool(true, valid))
+		b = AppendString(b, null.NewString("", valid))
+		b = AppendTime(b, null.NewTime(created, valid))
+		b = AppendTimestamp(b, null.NewTimestamp(created, valid))
+
+		i, b, err := ReadInt64(b)
+		maybePanic(err)
+		assertValid(t, i.Valid, valid, i.Int64 == math.MinInt64, "Int64")
+		i32, b, err := ReadInt32(b)
+		maybePanic(err)
+		assertValid(t, i32.Valid, valid, i32.Int32 == math.MaxInt32, "Int32")
+		f, b, err := ReadFloat(b)
+		maybePanic(err)
+		assertValid(t, f.Valid, valid, f.Float64 == 1.2345, "Float")
+		bo, b, err := ReadBool(b)
+		maybePanic(err)
+		assertValid(t, bo.Valid, valid, !bo.Bool, "Bool")
+		lb, b, err := ReadLenientBool(b)
+		maybePanic(err)
+		assertValid(t, lb.Valid, valid, lb.Bool.Bool, "LenientBool")
+		s, b, err := ReadString(b)
+		maybePanic(err)
+		assertValid(t, s.Valid, valid, s.String == "", "String")
+		ti, b, err := ReadTime(b)
+		maybePanic(err)
+		assertValid(t, ti.Valid, valid, ti.Time.Equal(created), "Time")
+		ts, b, err := ReadTimestamp(b)
+		maybePanic(err)
+		assertValid(t, ts.Valid, valid, ts.Time.Equal(created), "Timestamp")
+
+		if len(b) != 0 {
+			t.Errorf("%d bytes left over", len(b))
+		}
+	}
+}
+
+func TestAppendNull(t *testing.T) {
+	created := time.Date(2013, 3, 21, 20, 4, 0, 0, time.UTC)
+	tests := []struct {
+		b    []byte
+		want string
+	}{
+		{AppendInt64(nil, null.Int64From(1)), "01"},
+		{AppendInt64(nil, null.Int64{}), "f6"},
+		{AppendInt32(nil, null.Int32From(-1)), "20"},
+		{AppendFloat(nil, null.FloatFrom(1.1)), "fb3ff199999999999a"},
+		{AppendBool(nil, null.BoolFrom(true)), "f5"},
+		{AppendString(nil, null.StringFrom("IETF")), "6449455446"},
+		{AppendTime(nil, null.TimeFrom(created)), "c074323031332d30332d32315432303a30343a30305a"},
+		{AppendTimestamp(nil, null.TimestampFrom(created)), "c11a514b67b0"},
+		{AppendTimestamp(nil, null.Timestamp{}), "f6"},
+	}
+	for _, test := range tests {
+		if !bytes.Equal(test.b, decodeHex(test.want)) {
+			t.Errorf("got % x, want %s", test.b, test.want)
+		}
+	}
+}
+
+func TestReadUndefined(t *testing.T) {
+	b := []byte{simpleUndefined, simpleUndefined, simpleUndefined}
+	i, b, err := ReadInt64(b)
+	maybePanic(err)
+	assertValid(t, i.Valid, false, false, "Int64")
+	s, b, err := ReadString(b)
+	maybePanic(err)
+	assertValid(t, s.Valid, false, false, "String")
+	ts, b, err := ReadTimestamp(b)
+	maybePanic(err)
+	assertValid(t, ts.Valid, false, false, "Timestamp")
+	if len(b) != 0 {
+		t.Errorf("%d bytes left over", len(b))
+	}
+}
+
+func TestReadConversions(t *testing.T) {
+	f, _, err := ReadFloat(decodeHex("f93e00"))
+	maybePanic(err)
+	assertValid(t, f.Valid, true, f.Float64 == 1.5, "Float from half")
+	f, _, err = ReadFloat(decodeHex("3903e7"))
+	maybePanic(err)
+	assertValid(t, f.Valid, true, f.Float64 == -1000, "Float from integer")
+	s, _, err := ReadString(decodeHex("4449455446"))
+	maybePanic(err)
+	assertValid(t, s.Valid, true, s.String == "IETF", "String from byte string")
+
+	rfc := time.Date(2013, 3, 21, 20, 4, 0, 0, time.UTC)
+	ti, _, err := ReadTime(decodeHex("c11a514b67b0"))
+	maybePanic(err)
+	assertValid(t, ti.Valid, true, ti.Time.Equal(rfc), "Time from tag 1")
+	ts, _, err := ReadTimestamp(decodeHex("c074323031332d30332d32315432303a30343a30305a"))
+	maybePanic(err)
+	assertValid(t, ts.Valid, true, ts.Time.Equal(rfc), "Timestamp from tag 0")
+}
+
+func TestReadInt32Overflow(t *testing.T) {
+	b := AppendInt64(nil, null.Int64From(math.MaxInt32+1))
+	if _, rest, err := ReadInt32(b); !errors.Is(err, ErrOverflow) || !bytes.Equal(rest, b) {
+		t.Errorf("ReadInt32(MaxInt32+1): got %v, want ErrOverflow", err)
+	}
+	b = AppendInt64(nil, null.Int64From(math.MinInt32-1))
+	if _, _, err := ReadInt32(b); !errors.Is(err, ErrOverflow) {
+		t.Errorf("ReadInt32(MinInt32-1): got %v, want ErrOverflow", err)
+	}
+}
+
+func TestReadLenientBool(t *testing.T) {
+	tests := []struct {
+		b     []byte
+		want  bool
+		valid bool
+	}{
+		{[]byte{simpleTrue}, true, true},
+		{[]byte{simpleFalse}, false, true},
+		{[]byte{0x01}, true, true},
+		{[]byte{0x18, 0x00}, false, true},
+		{appendText(nil, "yes"), true, true},
+		{appendText(nil, "off"), false, true},
+		{appendText(nil, ""), false, false},
+		{[]byte{simpleNull}, false, false},
+		{[]byte{simpleUndefined}, false, false},
+	}
+	for _, test := range tests {
+		v, rest, err := ReadLenientBool(test.b)
+		if err != nil || v.Valid != test.valid || (v.Valid && v.Bool.Bool != test.want) || len(rest) != 0 {
+			t.Errorf("ReadLenientBool(% x): got %v, % x, %v", test.b, v, rest, err)
+		}
+	}
+
+	for _, b := range [][]byte{{0x02}, {0x20}, appendText(nil, "maybe"), appendFloat(nil, 1), decodeHex("4131"), nil} {
+		if _, _, err := ReadLenientBool(b); err == nil {
+			t.Errorf("ReadLenientBool(% x): expected error", b)
+		}
+	}
+}
+
+func TestReadNullWrongType(t *testing.T) {
+	b := AppendString(nil, null.StringFrom("12"))
+	if _, rest, err := ReadInt64(b); !errors.Is(err, ErrType) || !bytes.Equal(rest, b) {
+		t.Errorf("ReadInt64(text): got %v, want ErrType", err)
+	}
+	if _, _, err := ReadTime(AppendInt64(nil, null.Int64From(1))); !errors.Is(err, ErrType) {
+		t.Errorf("ReadTime(int): got %v, want ErrType", err)
+	}
+	if _, _, err := ReadBool(AppendInt64(nil, null.Int64From(1))); !errors.Is(err, ErrType) {
+		t.Errorf("ReadBool(int): got %v, want ErrType", err)
+	}
+	if _, _, err := ReadString(AppendInt64(nil, null.Int64From(1))); !errors.Is(err, ErrType) {
+		t.Errorf("ReadString(int): got %v, want ErrType", err)
+	}
+}
+
+func assertValid(t *testing.T, valid, wantValid, valueOK bool, from string) {
+	t.Helper()
+	if valid != wantValid {
+		t.Errorf("%s: got valid %v, want %v", from, valid, wantValid)
+	}
+	if valid && !valueOK {
+		t.Errorf("%s: bad value", from)
+	}
+}
+
+func maybePanic(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,101 @@
+package cbor
+
+import "github.com/vitdevelop/null/zero"
+
+// AppendZeroInt64 appends v to b as an integer, which is 0 if v is null.
+func AppendZeroInt64(b []byte, v zero.Int64) []byte {
+	return appendInt(b, v.ValueOrZero())
+}
+
+// ReadZeroInt64 reads an integer or null from the start of b, returning the remaining bytes.
+// Both null and 0 are read as null.
+func ReadZeroInt64(b []byte) (zero.Int64, []byte, error) {
+	v, rest, err := ReadInt64(b)
+	return zero.Int64FromNull(v), rest, err
+}
+
+// AppendZeroInt32 appends v to b as an integer, which is 0 if v is null.
+func AppendZeroInt32(b []byte, v zero.Int32) []byte {
+	return appendInt(b, int64(v.ValueOrZero()))
+}
+
+// ReadZeroInt32 reads an integer or null from the start of b, returning the remaining bytes.
+// Both null and 0 are read as null. It returns ErrOverflow if the integer doesn't fit in an int32.
+func ReadZeroInt32(b []byte) (zero.Int32, []byte, error) {
+	v, rest, err := ReadInt32(b)
+	return zero.Int32FromNull(v), rest, err
+}
+
+// AppendZeroFloat appends v to b as a double-precision float, which is 0 if v is null.
+func AppendZeroFloat(b []byte, v zero.Float) []byte {
+	return appendFloat(b, v.ValueOrZero())
+}
+
+// ReadZeroFloat reads a float, integer or null from the start of b, returning the remaining bytes.
+// Both null and 0 are read as null.
+func ReadZeroFloat(b []byte) (zero.Float, []byte, error) {
+	v, rest, err := ReadFloat(b)
+	return zero.FloatFromNull(v), rest, err
+}
+
+// AppendZeroBool appends v to b as a bool, which is false if v is null.
+func AppendZeroBool(b []byte, v zero.Bool) []byte {
+	return appendBool(b, v.ValueOrZero())
+}
+
+// ReadZeroBool reads a bool or null from the start of b, returning the remaining bytes.
+// Both null and false are read as null.
+func ReadZeroBool(b []byte) (zero.Bool, []byte, error) {
+	v, rest, err := ReadBool(b)
+	return zero.BoolFromNull(v), rest, err
+}
+
+// AppendZeroLenientBool appends v to b as a bool, which is false if v is null.
+func AppendZeroLenientBool(b []byte, v zero.LenientBool) []byte {
+	return AppendZeroBool(b, v.Bool)
+}
+
+// ReadZeroLenientBool reads a bool, the integers 0 or 1, a text string accepted by LenientBool.UnmarshalText,
+// or null from the start of b, returning the remaining bytes. Any false input is read as null.
+func ReadZeroLenientBool(b []byte) (zero.LenientBool, []byte, error) {
+	v, rest, err := ReadLenientBool(b)
+	return zero.LenientBool{Bool: zero.BoolFromNull(v.Bool)}, rest, err
+}
+
+// AppendZeroString appends v to b as a text string, which is empty if v is null.
+func AppendZeroString(b []byte, v zero.String) []byte {
+	return appendText(b, v.ValueOrZero())
+}
+
+// ReadZeroString reads a text string, byte string or null from the start of b, returning the remaining bytes.
+// Both null and the empty string are read as null.
+func ReadZeroString(b []byte) (zero.String, []byte, error) {
+	v, rest, err := ReadString(b)
+	return zero.StringFromNull(v), rest, err
+}
+
+// AppendZeroTime appends v to b as a tag 0 RFC 3339 text string, which holds the zero time.Time if v is null.
+func AppendZeroTime(b []byte, v zero.Time) []byte {
+	return appendDateTime(b, v.ValueOrZero())
+}
+
+// ReadZeroTime reads a tag 0 date/time string, a tag 1 epoch-based time or null from the start of b,
+// returning the remaining bytes.
+// Both null and the zero time.Time are read as null.
+func ReadZeroTime(b []byte) (zero.Time, []byte, error) {
+	v, rest, err := ReadTime(b)
+	return zero.TimeFromNull(v), rest, err
+}
+
+// AppendZeroTimestamp appends v to b as a tag 1 epoch-based time, which holds the zero time.Time if v is null.
+func AppendZeroTimestamp(b []byte, v zero.Timestamp) []byte {
+	return appendEpoch(b, v.ValueOrZero())
+}
+
+// ReadZeroTimestamp reads a tag 1 epoch-based time, a tag 0 date/time string or null from the start of b,
+// returning the remaining bytes.
+// Both null and the zero time.Time are read as null.
+func ReadZeroTimestamp(b []byte) (zero.Timestamp, []byte, error) {
+	v, rest, err := ReadTimestamp(b)
+	return zero.TimestampFromNull(v), rest, err
+}
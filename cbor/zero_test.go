@@ -0,0 +1,107 @@
+package cbor
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/vitdevelop/null"
+	"github.com/vitdevelop/null/zero"
+)
+
+func TestZeroRoundTrip(t *testing.T) {
+	created := time.Date(2012, 12, 21, 21, 21, 21, 0, time.UTC)
+	var b []byte
+	b = AppendZeroInt64(b, zero.Int64From(12345))
+	b = AppendZeroInt32(b, zero.Int32From(-5))
+	b = AppendZeroFloat(b, zero.FloatFrom(1.2345))
+	b = AppendZeroBool(b, zero.BoolFrom(true))
+	b = AppendZeroLenientBool(b, zero.LenientBoolFrom(true))
+	b = AppendZeroString(b, zero.StringFrom("test"))
+	b = AppendZeroTime(b, zero.TimeFrom(created))
+	b = AppendZeroTimestamp(b, zero.TimestampFrom(created))
+
+	i, b, err := ReadZeroInt64(b)
+	maybePanic(err)
+	assertValid(t, i.Valid, true, i.Int64 == 12345, "Int64")
+	i32, b, err := ReadZeroInt32(b)
+	maybePanic(err)
+	assertValid(t, i32.Valid, true, i32.Int32 == -5, "Int32")
+	f, b, err := ReadZeroFloat(b)
+	maybePanic(err)
+	assertValid(t, f.Valid, true, f.Float64 == 1.2345, "Float")
+	bo, b, err := ReadZeroBool(b)
+	maybePanic(err)
+	assertValid(t, bo.Valid, true, bo.Bool, "Bool")
+	lb, b, err := ReadZeroLenientBool(b)
+	maybePanic(err)
+	assertValid(t, lb.Valid, true, lb.Bool.Bool, "LenientBool")
+	s, b, err := ReadZeroString(b)
+	maybePanic(err)
+	assertValid(t, s.Valid, true, s.String == "test", "String")
+	ti, b, err := ReadZeroTime(b)
+	maybePanic(err)
+	assertValid(t, ti.Valid, true, ti.Time.Equal(created), "Time")
+	ts, b, err := ReadZeroTimestamp(b)
+	maybePanic(err)
+	assertValid(t, ts.Valid, true, ts.Time.Equal(created), "Timestamp")
+
+	if len(b) != 0 {
+		t.Errorf("%d bytes left over", len(b))
+	}
+}
+
+func TestZeroNull(t *testing.T) {
+	// null is encoded as the zero value
+	var b []byte
+	b = AppendZeroInt64(b, zero.NewInt64(5, false))
+	b = AppendZeroInt32(b, zero.Int32{})
+	b = AppendZeroFloat(b, zero.Float{})
+	b = AppendZeroBool(b, zero.NewBool(true, false))
+	b = AppendZeroLenientBool(b, zero.LenientBool{})
+	b = AppendZeroString(b, zero.NewString("test", false))
+	want := []byte{0x00, 0x00, floatDouble, 0, 0, 0, 0, 0, 0, 0, 0, simpleFalse, simpleFalse, 0x60}
+	if !bytes.Equal(b, want) {
+		t.Errorf("got % x, want % x", b, want)
+	}
+	b = AppendZeroTime(b, zero.Time{})
+	b = AppendZeroTimestamp(b, zero.Timestamp{})
+	// null and undefined are read as null as well
+	b = AppendInt64(b, null.Int64{})
+	b = append(b, simpleUndefined)
+
+	i, b, err := ReadZeroInt64(b)
+	maybePanic(err)
+	assertValid(t, i.Valid, false, false, "Int64")
+	i32, b, err := ReadZeroInt32(b)
+	maybePanic(err)
+	assertValid(t, i32.Valid, false, false, "Int32")
+	f, b, err := ReadZeroFloat(b)
+	maybePanic(err)
+	assertValid(t, f.Valid, false, false, "Float")
+	bo, b, err := ReadZeroBool(b)
+	maybePanic(err)
+	assertValid(t, bo.Valid, false, false, "Bool")
+	lb, b, err := ReadZeroLenientBool(b)
+	maybePanic(err)
+	assertValid(t, lb.Valid, false, false, "LenientBool")
+	s, b, err := ReadZeroString(b)
+	maybePanic(err)
+	assertValid(t, s.Valid, false, false, "String")
+	ti, b, err := ReadZeroTime(b)
+	maybePanic(err)
+	assertValid(t, ti.Valid, false, false, "Time")
+	ts, b, err := ReadZeroTimestamp(b)
+	maybePanic(err)
+	assertValid(t, ts.Valid, false, false, "Timestamp")
+	i, b, err = ReadZeroInt64(b)
+	maybePanic(err)
+	assertValid(t, i.Valid, false, false, "null Int64")
+	s, b, err = ReadZeroString(b)
+	maybePanic(err)
+	assertValid(t, s.Valid, false, false, "undefined String")
+
+	if len(b) != 0 {
+		t.Errorf("%d bytes left over", len(b))
+	}
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	a := "a\nb\nc\nd\ne\nf\ng\nh\ni\nj\nk\nl\nm\n"
+	b := "a\nB\nc\nd\ne\nf\ng\nh\ni\nj\nk\nl\nm\nn\n"
+	want := `--- a/x.go
++++ b/x.go
+@@ -1,5 +1,5 @@
+ a
+-b
++B
+ c
+ d
+ e
+@@ -11,3 +11,4 @@
+ k
+ l
+ m
++n
+`
+	if got := unifiedDiff("x.go", []byte(a), []byte(b)); got != want {
+		t.Errorf("bad diff:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestUnifiedDiffEqual(t *testing.T) {
+	if got := unifiedDiff("x.go", []byte("a\nb\n"), []byte("a\nb\n")); got != "" {
+		t.Errorf("expected no diff, got:\n%s", got)
+	}
+}
+
+func TestUnifiedDiffEmpty(t *testing.T) {
+	got := unifiedDiff("x.go", nil, []byte("a\n"))
+	if !strings.HasSuffix(got, "@@ -0,0 +1 @@\n+a\n") {
+		t.Errorf("bad diff:\n%s", got)
+	}
+}
+
+func TestDiffLines(t *testing.T) {
+	a := splitLines("x\ny\nz\n")
+	b := splitLines("y\nz\nw\n")
+	var kinds strings.Builder
+	for _, e := range diffLines(a, b) {
+		kinds.WriteByte(e.kind)
+	}
+	if kinds.String() != "-  +" {
+		t.Errorf("bad edit script: %q", kinds.String())
+	}
+}
@@ -0,0 +1,50 @@
+// Command nullmigrate rewrites Go packages to use github.com/vitdevelop/null.
+//
+// It changes imports of gopkg.in/guregu/null and github.com/guregu/null (including their zero subpackages)
+// to this module, renaming the identifiers that differ: Int becomes Int64, IntFrom becomes Int64From, and so on.
+// Identifiers that have no counterpart in this module, such as Value[T], are reported;
+// the github.com/vitdevelop/null/compat packages provide them.
+//
+// With -pointers, it also converts struct fields of type *int64, *int32, *float64, *bool, *string and *time.Time
+// into the corresponding null types. Assignments to converted fields are wrapped in the XFromPtr constructors,
+// and other reads of them call Ptr(), so the surrounding code keeps working with pointers.
+//
+// Usage:
+//
+//	nullmigrate [-dry-run] [-pointers] dir...
+//
+// Each directory is treated as a single package. With -dry-run, no files are modified,
+// and a unified diff of the changes is printed instead.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+func main() {
+	dryRun := flag.Bool("dry-run", false, "print a diff instead of rewriting files")
+	pointers := flag.Bool("pointers", false, "convert pointer struct fields to null types")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: nullmigrate [-dry-run] [-pointers] dir...\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	opts := options{pointers: *pointers}
+	failed := false
+	for _, dir := range flag.Args() {
+		if err := migrateDir(dir, opts, *dryRun); err != nil {
+			fmt.Fprintln(os.Stderr, "nullmigrate:", err)
+			failed = true
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,309 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// modulePath is the import path of this module's null package.
+const modulePath = "github.com/vitdevelop/null"
+
+// importRewrites maps upstream import paths to the ones in this module.
+var importRewrites = map[string]string{
+	"gopkg.in/guregu/null.v3":        modulePath,
+	"gopkg.in/guregu/null.v3/zero":   modulePath + "/zero",
+	"gopkg.in/guregu/null.v4":        modulePath,
+	"gopkg.in/guregu/null.v4/zero":   modulePath + "/zero",
+	"github.com/guregu/null":         modulePath,
+	"github.com/guregu/null/zero":    modulePath + "/zero",
+	"github.com/guregu/null/v5":      modulePath,
+	"github.com/guregu/null/v5/zero": modulePath + "/zero",
+}
+
+// identRewrites maps upstream identifiers to their names in this module.
+var identRewrites = map[string]string{
+	"Int":        "Int64",
+	"NewInt":     "NewInt64",
+	"IntFrom":    "Int64From",
+	"IntFromPtr": "Int64FromPtr",
+}
+
+// unsupportedIdents are upstream identifiers that have no counterpart in this module.
+var unsupportedIdents = map[string]bool{
+	"Value":        true,
+	"NewValue":     true,
+	"ValueFrom":    true,
+	"ValueFromPtr": true,
+	"Int16":        true,
+	"NewInt16":     true,
+	"Int16From":    true,
+	"Int16FromPtr": true,
+	"Byte":         true,
+	"NewByte":      true,
+	"ByteFrom":     true,
+	"ByteFromPtr":  true,
+}
+
+// options controls which rewrites migrate applies.
+type options struct {
+	// pointers enables converting pointer struct fields to null types.
+	pointers bool
+}
+
+// migrateDir rewrites the Go files in dir.
+// If dryRun is set, it prints a unified diff of the changes to stdout instead of writing them.
+func migrateDir(dir string, opts options, dryRun bool) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	fset := token.NewFileSet()
+	packages := make(map[string][]*ast.File)
+	sources := make(map[*ast.File][]byte)
+	paths := make(map[*ast.File]string)
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".go") {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		src, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		f, err := parser.ParseFile(fset, path, src, parser.ParseComments)
+		if err != nil {
+			return err
+		}
+		packages[f.Name.Name] = append(packages[f.Name.Name], f)
+		sources[f] = src
+		paths[f] = path
+	}
+
+	names := make([]string, 0, len(packages))
+	for name := range packages {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		files := packages[name]
+		changed, warnings := migrate(fset, files, opts)
+		for _, w := range warnings {
+			fmt.Fprintln(os.Stderr, w)
+		}
+		for _, f := range files {
+			if !changed[f] {
+				continue
+			}
+			out, err := formatFile(fset, f)
+			if err != nil {
+				return fmt.Errorf("%s: %w", paths[f], err)
+			}
+			if bytes.Equal(out, sources[f]) {
+				continue
+			}
+			if dryRun {
+				fmt.Print(unifiedDiff(filepath.ToSlash(paths[f]), sources[f], out))
+				continue
+			}
+			if err := os.WriteFile(paths[f], out, 0o644); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// migrate rewrites the files of a single package in place.
+// It returns the files that were changed, and warnings about code that needs manual attention.
+func migrate(fset *token.FileSet, files []*ast.File, opts options) (map[*ast.File]bool, []string) {
+	changed := make(map[*ast.File]bool)
+	var warnings []string
+
+	for _, f := range files {
+		ok, w := rewriteImports(fset, f)
+		if ok {
+			changed[f] = true
+		}
+		warnings = append(warnings, w...)
+	}
+
+	if opts.pointers {
+		info := &types.Info{
+			Types: make(map[ast.Expr]types.TypeAndValue),
+			Defs:  make(map[*ast.Ident]types.Object),
+			Uses:  make(map[*ast.Ident]types.Object),
+		}
+		conf := types.Config{
+			Importer: importer.ForCompiler(fset, "source", nil),
+			// keep going: dependencies that can't be loaded shouldn't prevent converting fields
+			Error: func(error) {},
+		}
+		_, _ = conf.Check(files[0].Name.Name, fset, files, info)
+
+		c := &pointerConverter{fset: fset, info: info, fields: make(map[*types.Var]string)}
+		for f := range c.convert(files) {
+			changed[f] = true
+		}
+		warnings = append(warnings, c.warnings...)
+	}
+
+	return changed, warnings
+}
+
+// rewriteImports changes upstream imports in f to this module, and renames the identifiers that differ.
+func rewriteImports(fset *token.FileSet, f *ast.File) (bool, []string) {
+	changed := false
+	local := make(map[string]bool)
+	for _, spec := range f.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+		newPath, ok := importRewrites[path]
+		if !ok {
+			continue
+		}
+		spec.Path.Value = strconv.Quote(newPath)
+		changed = true
+		if spec.Name != nil {
+			local[spec.Name.Name] = true
+		} else {
+			local[filepath.Base(newPath)] = true
+		}
+	}
+	if !changed {
+		return false, nil
+	}
+
+	var warnings []string
+	ast.Inspect(f, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		pkg, ok := sel.X.(*ast.Ident)
+		// identifiers referring to imports aren't resolved by the parser
+		if !ok || pkg.Obj != nil || !local[pkg.Name] {
+			return true
+		}
+		if name, ok := identRewrites[sel.Sel.Name]; ok {
+			sel.Sel.Name = name
+		} else if unsupportedIdents[sel.Sel.Name] {
+			warnings = append(warnings, fmt.Sprintf("%s: %s.%s has no counterpart in %s; import %s/compat instead",
+				fset.Position(sel.Pos()), pkg.Name, sel.Sel.Name, modulePath, modulePath))
+		}
+		return true
+	})
+	return true, warnings
+}
+
+// importName returns the local name of the import of path in f, adding the import if it is missing.
+func importName(f *ast.File, path string) string {
+	for _, spec := range f.Imports {
+		if p, _ := strconv.Unquote(spec.Path.Value); p == path {
+			if spec.Name != nil {
+				return spec.Name.Name
+			}
+			return filepath.Base(path)
+		}
+	}
+
+	spec := &ast.ImportSpec{Path: &ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(path)}}
+	f.Imports = append(f.Imports, spec)
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.IMPORT {
+			continue
+		}
+		if !gen.Lparen.IsValid() {
+			// force parentheses, otherwise only the first spec is printed
+			gen.Lparen = gen.Pos()
+			gen.Rparen = gen.End()
+		}
+		gen.Specs = append(gen.Specs, spec)
+		return filepath.Base(path)
+	}
+	f.Decls = append([]ast.Decl{&ast.GenDecl{Tok: token.IMPORT, Specs: []ast.Spec{spec}}}, f.Decls...)
+	return filepath.Base(path)
+}
+
+// removeImportIfUnused removes the import of path from f if nothing refers to it anymore.
+func removeImportIfUnused(f *ast.File, path string) {
+	name := ""
+	for _, spec := range f.Imports {
+		if p, _ := strconv.Unquote(spec.Path.Value); p == path {
+			if spec.Name != nil {
+				name = spec.Name.Name
+			} else {
+				name = filepath.Base(path)
+			}
+		}
+	}
+	if name == "" || name == "_" || name == "." {
+		return
+	}
+
+	used := false
+	ast.Inspect(f, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if id, ok := sel.X.(*ast.Ident); ok && id.Obj == nil && id.Name == name {
+				used = true
+			}
+		}
+		return !used
+	})
+	if used {
+		return
+	}
+
+	for i, spec := range f.Imports {
+		if p, _ := strconv.Unquote(spec.Path.Value); p == path {
+			f.Imports = append(f.Imports[:i], f.Imports[i+1:]...)
+			break
+		}
+	}
+	for i := 0; i < len(f.Decls); i++ {
+		gen, ok := f.Decls[i].(*ast.GenDecl)
+		if !ok || gen.Tok != token.IMPORT {
+			continue
+		}
+		for j, spec := range gen.Specs {
+			if p, _ := strconv.Unquote(spec.(*ast.ImportSpec).Path.Value); p == path {
+				gen.Specs = append(gen.Specs[:j], gen.Specs[j+1:]...)
+				if len(gen.Specs) == 1 {
+					// print a lone remaining import without parentheses
+					gen.Lparen = token.NoPos
+				}
+				break
+			}
+		}
+		if len(gen.Specs) == 0 {
+			f.Decls = append(f.Decls[:i], f.Decls[i+1:]...)
+			i--
+		}
+	}
+}
+
+// formatFile prints f and formats the result like gofmt.
+func formatFile(fset *token.FileSet, f *ast.File) ([]byte, error) {
+	ast.SortImports(fset, f)
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, f); err != nil {
+		return nil, err
+	}
+	// a second pass cleans up the layout of nodes added without positions
+	return format.Source(buf.Bytes())
+}
@@ -0,0 +1,241 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRewriteImports(t *testing.T) {
+	src := `package p
+
+import (
+	"gopkg.in/guregu/null.v4"
+	nz "gopkg.in/guregu/null.v4/zero"
+)
+
+type Row struct {
+	ID   null.Int
+	Name null.String
+	Rank nz.Int
+}
+
+func newRow(id int64, rank *int64) Row {
+	return Row{ID: null.IntFrom(id), Name: null.NewString("", false), Rank: nz.IntFromPtr(rank)}
+}
+
+func empty() null.Int {
+	return null.NewInt(0, false)
+}
+`
+	want := `package p
+
+import (
+	"github.com/vitdevelop/null"
+	nz "github.com/vitdevelop/null/zero"
+)
+
+type Row struct {
+	ID   null.Int64
+	Name null.String
+	Rank nz.Int64
+}
+
+func newRow(id int64, rank *int64) Row {
+	return Row{ID: null.Int64From(id), Name: null.NewString("", false), Rank: nz.Int64FromPtr(rank)}
+}
+
+func empty() null.Int64 {
+	return null.NewInt64(0, false)
+}
+`
+	got, warnings := migrateSource(t, src, options{})
+	assertSource(t, got, want)
+	if len(warnings) != 0 {
+		t.Errorf("unexpected warnings: %v", warnings)
+	}
+}
+
+func TestRewriteImportsShadowed(t *testing.T) {
+	src := `package p
+
+import "github.com/guregu/null/v5"
+
+type wrapper struct{ Int int }
+
+func f(v null.Int) int {
+	null := wrapper{}
+	return null.Int + int(v.Int64)
+}
+`
+	want := `package p
+
+import "github.com/vitdevelop/null"
+
+type wrapper struct{ Int int }
+
+func f(v null.Int64) int {
+	null := wrapper{}
+	return null.Int + int(v.Int64)
+}
+`
+	got, _ := migrateSource(t, src, options{})
+	assertSource(t, got, want)
+}
+
+func TestRewriteImportsUnsupported(t *testing.T) {
+	src := `package p
+
+import "github.com/guregu/null/v5"
+
+var v = null.ValueFrom(1)
+`
+	_, warnings := migrateSource(t, src, options{})
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "null.ValueFrom has no counterpart") {
+		t.Errorf("expected a warning about null.ValueFrom, got %v", warnings)
+	}
+}
+
+func TestConvertPointers(t *testing.T) {
+	src := `package p
+
+import "time"
+
+type User struct {
+	ID      int64
+	Age     *int64
+	Name    *string
+	Deleted *time.Time
+	Ratio   *float64
+}
+
+func update(u *User, age *int64, other User) {
+	u.Age = age
+	u.Name = nil
+	u.Ratio = other.Ratio
+	*u.Name = "gopher"
+	if u.Deleted != nil {
+		println(*u.Age, u.Deleted.Unix())
+	}
+}
+
+func make(name string) User {
+	return User{ID: 1, Name: &name}
+}
+
+func positional(age *int64) User {
+	return User{1, age, nil, nil, nil}
+}
+`
+	want := `package p
+
+import "github.com/vitdevelop/null"
+
+type User struct {
+	ID      int64
+	Age     null.Int64
+	Name    null.String
+	Deleted null.Time
+	Ratio   null.Float
+}
+
+func update(u *User, age *int64, other User) {
+	u.Age = null.Int64FromPtr(age)
+	u.Name = null.StringFromPtr(nil)
+	u.Ratio = other.Ratio
+	u.Name.SetValid("gopher")
+	if u.Deleted.Ptr() != nil {
+		println(*u.Age.Ptr(), u.Deleted.Ptr().Unix())
+	}
+}
+
+func make(name string) User {
+	return User{ID: 1, Name: null.StringFromPtr(&name)}
+}
+
+func positional(age *int64) User {
+	return User{1, null.Int64FromPtr(age), null.StringFromPtr(nil), null.TimeFromPtr(nil), null.FloatFromPtr(nil)}
+}
+`
+	got, warnings := migrateSource(t, src, options{pointers: true})
+	assertSource(t, got, want)
+	if len(warnings) != 0 {
+		t.Errorf("unexpected warnings: %v", warnings)
+	}
+}
+
+func TestConvertPointersWarnings(t *testing.T) {
+	src := `package p
+
+type Counter struct {
+	N *int64
+}
+
+func inc(c *Counter) {
+	*c.N++
+}
+`
+	_, warnings := migrateSource(t, src, options{pointers: true})
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "increment or decrement of converted field N") {
+		t.Errorf("expected a warning about incrementing N, got %v", warnings)
+	}
+}
+
+func TestConvertPointersDisabled(t *testing.T) {
+	src := `package p
+
+type User struct {
+	Age *int64
+}
+`
+	got, _ := migrateSource(t, src, options{})
+	assertSource(t, got, src)
+}
+
+func TestMigrateDir(t *testing.T) {
+	dir := t.TempDir()
+	src := "package p\n\nimport \"gopkg.in/guregu/null.v4\"\n\nvar n = null.IntFrom(1)\n"
+	path := filepath.Join(dir, "p.go")
+	if err := os.WriteFile(path, []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := migrateDir(dir, options{}, false); err != nil {
+		t.Fatal(err)
+	}
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertSource(t, string(got), "package p\n\nimport \"github.com/vitdevelop/null\"\n\nvar n = null.Int64From(1)\n")
+}
+
+// migrateSource migrates a single file package and returns the formatted result.
+func migrateSource(t *testing.T, src string, opts options) (string, []string) {
+	t.Helper()
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "p.go", src, parser.ParseComments)
+	if err != nil {
+		t.Fatal(err)
+	}
+	changed, warnings := migrate(fset, []*ast.File{f}, opts)
+	if !changed[f] {
+		return src, warnings
+	}
+	out, err := formatFile(fset, f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(out), warnings
+}
+
+func assertSource(t *testing.T, got, want string) {
+	t.Helper()
+	if got != want {
+		t.Errorf("unexpected result:\n%s", unifiedDiff("p.go", []byte(want), []byte(got)))
+	}
+}
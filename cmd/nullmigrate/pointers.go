@@ -0,0 +1,327 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"reflect"
+)
+
+// pointerTypes maps the element types of convertible pointer fields to the null types replacing them.
+var pointerTypes = map[string]string{
+	"int64":     "Int64",
+	"int32":     "Int32",
+	"float64":   "Float",
+	"bool":      "Bool",
+	"string":    "String",
+	"time.Time": "Time",
+}
+
+// pointerConverter converts pointer struct fields to null types and rewrites their uses.
+type pointerConverter struct {
+	fset *token.FileSet
+	info *types.Info
+	// fields maps converted fields to the name of their null type.
+	fields map[*types.Var]string
+	// handled holds uses of converted fields that have already been rewritten.
+	handled  map[*ast.SelectorExpr]bool
+	warnings []string
+}
+
+// convert converts the pointer fields declared in files and rewrites their uses,
+// returning the files that were changed.
+func (c *pointerConverter) convert(files []*ast.File) map[*ast.File]bool {
+	changed := make(map[*ast.File]bool)
+	for _, f := range files {
+		if c.convertFields(f) {
+			changed[f] = true
+		}
+	}
+	if len(c.fields) == 0 {
+		return changed
+	}
+
+	c.handled = make(map[*ast.SelectorExpr]bool)
+	for _, f := range files {
+		if c.rewriteUses(f) {
+			changed[f] = true
+		}
+		if changed[f] {
+			removeImportIfUnused(f, "time")
+		}
+	}
+	return changed
+}
+
+// convertFields changes the types of the convertible struct fields declared in f.
+func (c *pointerConverter) convertFields(f *ast.File) bool {
+	changed := false
+	ast.Inspect(f, func(n ast.Node) bool {
+		st, ok := n.(*ast.StructType)
+		if !ok {
+			return true
+		}
+		for _, field := range st.Fields.List {
+			nullType, ok := pointerTypes[c.elemTypeName(field.Type)]
+			// embedded fields can't change type without changing their name
+			if !ok || len(field.Names) == 0 {
+				continue
+			}
+			vars := make([]*types.Var, 0, len(field.Names))
+			for _, name := range field.Names {
+				if v, ok := c.info.Defs[name].(*types.Var); ok {
+					vars = append(vars, v)
+				}
+			}
+			if len(vars) != len(field.Names) {
+				continue
+			}
+			for _, v := range vars {
+				c.fields[v] = nullType
+			}
+			field.Type = &ast.SelectorExpr{X: ast.NewIdent(importName(f, modulePath)), Sel: ast.NewIdent(nullType)}
+			changed = true
+		}
+		return true
+	})
+	return changed
+}
+
+// elemTypeName returns the name of the element type of a pointer type expression such as *int64 or *time.Time,
+// or "" if expr is not a pointer to a named type.
+func (c *pointerConverter) elemTypeName(expr ast.Expr) string {
+	star, ok := expr.(*ast.StarExpr)
+	if !ok {
+		return ""
+	}
+	switch elem := star.X.(type) {
+	case *ast.Ident:
+		if obj := c.info.Uses[elem]; obj != nil && obj.Parent() != types.Universe {
+			// a local type shadowing a predeclared one
+			return ""
+		}
+		return elem.Name
+	case *ast.SelectorExpr:
+		pkg, ok := elem.X.(*ast.Ident)
+		if !ok {
+			return ""
+		}
+		if name, ok := c.info.Uses[pkg].(*types.PkgName); ok && name.Imported().Path() != "time" {
+			return ""
+		} else if !ok && pkg.Name != "time" {
+			return ""
+		}
+		return "time." + elem.Sel.Name
+	}
+	return ""
+}
+
+// field returns the selector and null type name of the converted field selected by expr,
+// or an empty name if expr doesn't select a converted field.
+func (c *pointerConverter) field(expr ast.Expr) (*ast.SelectorExpr, string) {
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok {
+		return nil, ""
+	}
+	v, ok := c.info.Uses[sel.Sel].(*types.Var)
+	if !ok {
+		return nil, ""
+	}
+	return sel, c.fields[v]
+}
+
+// rewriteUses rewrites the uses of converted fields in f.
+// Assignments are wrapped in the null type's FromPtr constructor,
+// assignments through the pointer become SetValid calls, and other reads call Ptr().
+func (c *pointerConverter) rewriteUses(f *ast.File) bool {
+	changed := false
+	ast.Inspect(f, func(n ast.Node) bool {
+		var list []ast.Stmt
+		switch n := n.(type) {
+		case *ast.BlockStmt:
+			list = n.List
+		case *ast.CaseClause:
+			list = n.Body
+		case *ast.CommClause:
+			list = n.Body
+		case *ast.CompositeLit:
+			if c.rewriteCompositeLit(f, n) {
+				changed = true
+			}
+			return true
+		case *ast.IncDecStmt:
+			c.warnIfField(n.X, "increment or decrement")
+			return true
+		case *ast.UnaryExpr:
+			if n.Op == token.AND {
+				c.warnIfField(n.X, "address")
+			}
+			return true
+		default:
+			return true
+		}
+		for i, stmt := range list {
+			if s, ok := c.rewriteAssign(f, stmt); ok {
+				list[i] = s
+				changed = true
+			}
+		}
+		return true
+	})
+
+	replaceExprs(f, func(expr ast.Expr) ast.Expr {
+		sel, typ := c.field(expr)
+		if typ == "" || c.handled[sel] {
+			return expr
+		}
+		c.handled[sel] = true
+		changed = true
+		return &ast.CallExpr{Fun: &ast.SelectorExpr{X: sel, Sel: ast.NewIdent("Ptr")}}
+	})
+	return changed
+}
+
+// rewriteAssign rewrites assignments to converted fields in stmt,
+// returning the statement to replace it with.
+func (c *pointerConverter) rewriteAssign(f *ast.File, stmt ast.Stmt) (ast.Stmt, bool) {
+	assign, ok := stmt.(*ast.AssignStmt)
+	if !ok {
+		return stmt, false
+	}
+
+	if len(assign.Lhs) == 1 && len(assign.Rhs) == 1 {
+		if star, ok := assign.Lhs[0].(*ast.StarExpr); ok {
+			if sel, typ := c.field(star.X); typ != "" {
+				if assign.Tok != token.ASSIGN {
+					c.warn(sel, "compound assignment")
+					return stmt, false
+				}
+				c.handled[sel] = true
+				call := &ast.CallExpr{
+					Fun:  &ast.SelectorExpr{X: sel, Sel: ast.NewIdent("SetValid")},
+					Args: []ast.Expr{assign.Rhs[0]},
+				}
+				return &ast.ExprStmt{X: call}, true
+			}
+		}
+	}
+
+	if assign.Tok != token.ASSIGN || len(assign.Lhs) != len(assign.Rhs) {
+		return stmt, false
+	}
+	changed := false
+	for i, lhs := range assign.Lhs {
+		sel, typ := c.field(lhs)
+		if typ == "" {
+			continue
+		}
+		c.handled[sel] = true
+		assign.Rhs[i] = c.wrap(f, typ, assign.Rhs[i])
+		changed = true
+	}
+	return stmt, changed
+}
+
+// rewriteCompositeLit wraps the values of converted fields in a struct literal.
+func (c *pointerConverter) rewriteCompositeLit(f *ast.File, lit *ast.CompositeLit) bool {
+	tv, ok := c.info.Types[lit]
+	if !ok {
+		return false
+	}
+	st, ok := tv.Type.Underlying().(*types.Struct)
+	if !ok {
+		return false
+	}
+
+	changed := false
+	for i, elt := range lit.Elts {
+		var v *types.Var
+		value := &lit.Elts[i]
+		if kv, ok := elt.(*ast.KeyValueExpr); ok {
+			key, ok := kv.Key.(*ast.Ident)
+			if !ok {
+				continue
+			}
+			v, _ = c.info.Uses[key].(*types.Var)
+			value = &kv.Value
+		} else if i < st.NumFields() {
+			v = st.Field(i)
+		}
+		if typ := c.fields[v]; typ != "" {
+			*value = c.wrap(f, typ, *value)
+			changed = true
+		}
+	}
+	return changed
+}
+
+// wrap converts value, a pointer, to the null type typ.
+// Values that are converted fields of the same type are used as they are.
+func (c *pointerConverter) wrap(f *ast.File, typ string, value ast.Expr) ast.Expr {
+	if sel, other := c.field(value); other == typ {
+		c.handled[sel] = true
+		return value
+	}
+	pkg := importName(f, modulePath)
+	return &ast.CallExpr{
+		Fun:  &ast.SelectorExpr{X: ast.NewIdent(pkg), Sel: ast.NewIdent(typ + "FromPtr")},
+		Args: []ast.Expr{value},
+	}
+}
+
+// warnIfField reports a use of a converted field that can't be rewritten automatically.
+func (c *pointerConverter) warnIfField(expr ast.Expr, what string) {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if sel, typ := c.field(expr); typ != "" {
+		c.warn(sel, what)
+	}
+}
+
+// warn records a warning about a use of the converted field sel.
+func (c *pointerConverter) warn(sel *ast.SelectorExpr, what string) {
+	c.warnings = append(c.warnings, fmt.Sprintf("%s: %s of converted field %s needs to be updated manually",
+		c.fset.Position(sel.Pos()), what, sel.Sel.Name))
+}
+
+var (
+	exprType   = reflect.TypeOf((*ast.Expr)(nil)).Elem()
+	objectType = reflect.TypeOf((*ast.Object)(nil))
+	scopeType  = reflect.TypeOf((*ast.Scope)(nil))
+)
+
+// replaceExprs calls fn for every expression in the tree rooted at node, children first,
+// and replaces the expression with the one fn returns.
+// Only expressions held in fields or slices of type ast.Expr can be replaced.
+func replaceExprs(node ast.Node, fn func(ast.Expr) ast.Expr) {
+	replaceIn(reflect.ValueOf(node), fn)
+}
+
+func replaceIn(v reflect.Value, fn func(ast.Expr) ast.Expr) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		// objects and scopes link back into the tree
+		if v.IsNil() || v.Type() == objectType || v.Type() == scopeType {
+			return
+		}
+		replaceIn(v.Elem(), fn)
+	case reflect.Interface:
+		if v.IsNil() {
+			return
+		}
+		replaceIn(v.Elem(), fn)
+		if v.Type() == exprType && v.CanSet() {
+			v.Set(reflect.ValueOf(fn(v.Interface().(ast.Expr))))
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			replaceIn(v.Field(i), fn)
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			replaceIn(v.Index(i), fn)
+		}
+	}
+}
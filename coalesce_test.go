@@ -0,0 +1,84 @@
+package null
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCoalesce(t *testing.T) {
+	i := Coalesce(NewInt64(1, false), Int64From(0), Int64From(2))
+	assertInt64EqualIsTrue(t, i, Int64From(0))
+
+	s := Coalesce(NewString("a", false), StringFrom("b"))
+	assertStringEqualIsTrue(t, s, StringFrom("b"))
+
+	null := Coalesce(NewFloat(1, false), NewFloat(2, false))
+	assertNullFloat(t, null, "Coalesce() of nulls")
+
+	none := Coalesce[Bool]()
+	assertNullBool(t, none, "Coalesce() of nothing")
+}
+
+func TestNullIf(t *testing.T) {
+	assertNullInt64(t, NullIf(Int64From(-1), -1), "NullIf() sentinel")
+	assertInt64EqualIsTrue(t, NullIf(Int64From(5), -1), Int64From(5))
+	assertNullInt64(t, NullIf(NewInt64(-1, false), -1), "NullIf() null")
+	assertNullStr(t, NullIf(StringFrom("N/A"), "N/A"), "NullIf() string sentinel")
+	assertNullInt32(t, NullIf(Int32From(0), 0), "NullIf() zero sentinel")
+
+	loc := time.FixedZone("UTC+2", 2*60*60)
+	ti := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	assertNullTime(t, NullIf(TimeFrom(ti.In(loc)), ti), "NullIf() time in other location")
+	assertTimestamp(t, NullIf(TimestampFrom(timeValue1), timeValue3), "NullIf() different time")
+}
+
+func TestOrElse(t *testing.T) {
+	if v := NewInt64(1, false).OrElse(42); v != 42 {
+		t.Errorf("OrElse() = %d, want 42", v)
+	}
+	if v := Int64From(0).OrElse(42); v != 0 {
+		t.Errorf("OrElse() = %d, want 0", v)
+	}
+	if v := NewInt32(1, false).OrElse(42); v != 42 {
+		t.Errorf("OrElse() = %d, want 42", v)
+	}
+	if v := NewFloat(1, false).OrElse(1.5); v != 1.5 {
+		t.Errorf("OrElse() = %v, want 1.5", v)
+	}
+	if v := BoolFrom(false).OrElse(true); v != false {
+		t.Errorf("OrElse() = %v, want false", v)
+	}
+	if v := NewString("", false).OrElse("default"); v != "default" {
+		t.Errorf("OrElse() = %q, want default", v)
+	}
+	if v := StringFrom("").OrElse("default"); v != "" {
+		t.Errorf("OrElse() = %q, want blank", v)
+	}
+	if v := NewTime(timeValue1, false).OrElse(timeValue3); !v.Equal(timeValue3) {
+		t.Errorf("OrElse() = %v, want %v", v, timeValue3)
+	}
+	if v := TimestampFrom(timeValue1).OrElse(timeValue3); !v.Equal(timeValue1) {
+		t.Errorf("OrElse() = %v, want %v", v, timeValue1)
+	}
+}
+
+func TestOrElseGet(t *testing.T) {
+	called := false
+	get := func() int64 {
+		called = true
+		return 42
+	}
+
+	if v := Int64From(7).OrElseGet(get); v != 7 || called {
+		t.Errorf("OrElseGet() = %d (called: %t), want 7 without calling", v, called)
+	}
+	if v := NewInt64(7, false).OrElseGet(get); v != 42 || !called {
+		t.Errorf("OrElseGet() = %d (called: %t), want 42", v, called)
+	}
+	if v := NewString("", false).OrElseGet(func() string { return "x" }); v != "x" {
+		t.Errorf("OrElseGet() = %q, want x", v)
+	}
+	if v := NewTimestamp(timeValue1, false).OrElseGet(time.Time{}.UTC); !v.IsZero() {
+		t.Errorf("OrElseGet() = %v, want zero time", v)
+	}
+}
@@ -0,0 +1,60 @@
+package null
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func formatCents(c int64) string {
+	return fmt.Sprintf("$%d.%02d", c/100, c%100)
+}
+
+func TestMap(t *testing.T) {
+	price := Map[String](Int64From(1250), formatCents)
+	assertStringEqualIsTrue(t, price, StringFrom("$12.50"))
+
+	null := Map[String](NewInt64(1250, false), formatCents)
+	assertNullStr(t, null, "Map() null")
+
+	length := Map[Int32](StringFrom("hello"), func(s string) int32 { return int32(len(s)) })
+	assertInt32EqualIsTrue(t, length, Int32From(5))
+
+	// valid zero values are mapped too
+	zero := Map[Bool](Int64From(0), func(i int64) bool { return i == 0 })
+	assertBool(t, zero, "Map() zero")
+}
+
+func TestFlatMap(t *testing.T) {
+	parse := func(s string) Int64 {
+		var i Int64
+		if err := i.UnmarshalText([]byte(s)); err != nil {
+			return NewInt64(0, false)
+		}
+		return i
+	}
+
+	assertInt64EqualIsTrue(t, FlatMap(StringFrom("12345"), parse), Int64From(12345))
+	assertNullInt64(t, FlatMap(StringFrom("abc"), parse), "FlatMap() returning null")
+	assertNullInt64(t, FlatMap(NewString("12345", false), parse), "FlatMap() null")
+}
+
+func TestFilter(t *testing.T) {
+	positive := func(i int64) bool { return i > 0 }
+	assertInt64EqualIsTrue(t, Filter(Int64From(5), positive), Int64From(5))
+	assertNullInt64(t, Filter(Int64From(-5), positive), "Filter() rejected")
+	assertNullInt64(t, Filter(NewInt64(5, false), positive), "Filter() null")
+
+	notBlank := func(s string) bool { return strings.TrimSpace(s) != "" }
+	assertNullStr(t, Filter(StringFrom("  "), notBlank), "Filter() blank string")
+}
+
+func TestZip(t *testing.T) {
+	mul := func(p float64, q int64) float64 { return p * float64(q) }
+
+	total := Zip[Float](FloatFrom(2.5), Int64From(4), mul)
+	assertFloatEqualIsTrue(t, total, FloatFrom(10))
+
+	assertNullFloat(t, Zip[Float](NewFloat(2.5, false), Int64From(4), mul), "Zip() first null")
+	assertNullFloat(t, Zip[Float](FloatFrom(2.5), NewInt64(4, false), mul), "Zip() second null")
+}
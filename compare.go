@@ -0,0 +1,38 @@
+package null
+
+// NullsFirst compares a and b using their Compare method, ordering nulls before all valid values,
+// like SQL's NULLS FIRST. It can be passed to slices.SortFunc directly.
+// For descending order with nulls first, use -NullsLast(a, b).
+func NullsFirst[T interface {
+	Compare(T) int
+	IsZero() bool
+}](a, b T) int {
+	if a.IsZero() || b.IsZero() {
+		return compareBool(!a.IsZero(), !b.IsZero())
+	}
+	return a.Compare(b)
+}
+
+// NullsLast compares a and b using their Compare method, ordering nulls after all valid values,
+// like SQL's NULLS LAST. It can be passed to slices.SortFunc directly.
+// For descending order with nulls last, use -NullsFirst(a, b).
+func NullsLast[T interface {
+	Compare(T) int
+	IsZero() bool
+}](a, b T) int {
+	if a.IsZero() || b.IsZero() {
+		return compareBool(a.IsZero(), b.IsZero())
+	}
+	return a.Compare(b)
+}
+
+// compareBool compares two bools, considering false less than true.
+func compareBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case a:
+		return 1
+	}
+	return -1
+}
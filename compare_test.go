@@ -0,0 +1,63 @@
+package null
+
+import (
+	"math"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name string
+		got  int
+		want int
+	}{
+		{"int64 less", Int64From(1).Compare(Int64From(2)), -1},
+		{"int64 equal", Int64From(2).Compare(Int64From(2)), 0},
+		{"int64 greater", Int64From(3).Compare(Int64From(2)), 1},
+		{"int64 null less", NewInt64(5, false).Compare(Int64From(-5)), -1},
+		{"int64 null greater", Int64From(-5).Compare(NewInt64(5, false)), 1},
+		{"int64 both null", NewInt64(1, false).Compare(NewInt64(2, false)), 0},
+		{"int32 less", Int32From(1).Compare(Int32From(2)), -1},
+		{"float less", FloatFrom(1.5).Compare(FloatFrom(2)), -1},
+		{"float NaN", FloatFrom(math.NaN()).Compare(FloatFrom(math.Inf(-1))), -1},
+		{"float null", NewFloat(0, false).Compare(FloatFrom(math.NaN())), -1},
+		{"bool less", BoolFrom(false).Compare(BoolFrom(true)), -1},
+		{"bool null", NewBool(true, false).Compare(BoolFrom(false)), -1},
+		{"string greater", StringFrom("b").Compare(StringFrom("a")), 1},
+		{"string null", NewString("", false).Compare(StringFrom("")), -1},
+		{"time location", TimeFrom(timeValue1).Compare(TimeFrom(timeValue2)), 0},
+		{"time less", TimeFrom(timeValue1).Compare(TimeFrom(timeValue3)), -1},
+		{"timestamp location", TimestampFrom(timeValue2).Compare(TimestampFrom(timeValue1)), 0},
+		{"timestamp null", TimestampFrom(time.Time{}).Compare(NewTimestamp(timeValue1, false)), 1},
+	}
+	for _, test := range tests {
+		if test.got != test.want {
+			t.Errorf("%s: Compare() = %d, want %d", test.name, test.got, test.want)
+		}
+	}
+}
+
+func TestNullsFirstLast(t *testing.T) {
+	vals := []Int64{Int64From(3), NewInt64(0, false), Int64From(-1), NewInt64(0, false), Int64From(2)}
+
+	slices.SortFunc(vals, NullsFirst)
+	assertInt64Order(t, vals, "NULLS FIRST", NewInt64(0, false), NewInt64(0, false), Int64From(-1), Int64From(2), Int64From(3))
+
+	slices.SortFunc(vals, NullsLast)
+	assertInt64Order(t, vals, "NULLS LAST", Int64From(-1), Int64From(2), Int64From(3), NewInt64(0, false), NewInt64(0, false))
+
+	slices.SortFunc(vals, func(a, b Int64) int { return -NullsFirst(a, b) })
+	assertInt64Order(t, vals, "DESC NULLS LAST", Int64From(3), Int64From(2), Int64From(-1), NewInt64(0, false), NewInt64(0, false))
+
+	slices.SortFunc(vals, func(a, b Int64) int { return -NullsLast(a, b) })
+	assertInt64Order(t, vals, "DESC NULLS FIRST", NewInt64(0, false), NewInt64(0, false), Int64From(3), Int64From(2), Int64From(-1))
+}
+
+func assertInt64Order(t *testing.T, got []Int64, from string, want ...Int64) {
+	t.Helper()
+	if !slices.EqualFunc(got, want, Int64.Equal) {
+		t.Errorf("bad %s order: %v ≠ %v", from, got, want)
+	}
+}
@@ -0,0 +1,119 @@
+// Package null provides the API of gopkg.in/guregu/null on top of github.com/vitdevelop/null,
+// so code written against upstream can switch import paths without renaming identifiers.
+// The named types are aliases of this module's types, so values can be passed between both packages freely.
+// Use the zero subpackage for upstream's zero package.
+package null
+
+import (
+	"time"
+
+	"github.com/vitdevelop/null"
+)
+
+// String is a nullable string. It is an alias of null.String.
+type String = null.String
+
+// NewString creates a new String.
+func NewString(s string, valid bool) String {
+	return null.NewString(s, valid)
+}
+
+// StringFrom creates a new String that will always be valid.
+func StringFrom(s string) String {
+	return null.StringFrom(s)
+}
+
+// StringFromPtr creates a new String that will be null if s is nil.
+func StringFromPtr(s *string) String {
+	return null.StringFromPtr(s)
+}
+
+// Int is a nullable int64. It is an alias of null.Int64.
+type Int = null.Int64
+
+// NewInt creates a new Int.
+func NewInt(i int64, valid bool) Int {
+	return null.NewInt64(i, valid)
+}
+
+// IntFrom creates a new Int that will always be valid.
+func IntFrom(i int64) Int {
+	return null.Int64From(i)
+}
+
+// IntFromPtr creates a new Int that will be null if i is nil.
+func IntFromPtr(i *int64) Int {
+	return null.Int64FromPtr(i)
+}
+
+// Int32 is a nullable int32. It is an alias of null.Int32.
+type Int32 = null.Int32
+
+// NewInt32 creates a new Int32.
+func NewInt32(i int32, valid bool) Int32 {
+	return null.NewInt32(i, valid)
+}
+
+// Int32From creates a new Int32 that will always be valid.
+func Int32From(i int32) Int32 {
+	return null.Int32From(i)
+}
+
+// Int32FromPtr creates a new Int32 that will be null if i is nil.
+func Int32FromPtr(i *int32) Int32 {
+	return null.Int32FromPtr(i)
+}
+
+// Float is a nullable float64. It is an alias of null.Float.
+type Float = null.Float
+
+// NewFloat creates a new Float.
+func NewFloat(f float64, valid bool) Float {
+	return null.NewFloat(f, valid)
+}
+
+// FloatFrom creates a new Float that will always be valid.
+func FloatFrom(f float64) Float {
+	return null.FloatFrom(f)
+}
+
+// FloatFromPtr creates a new Float that will be null if f is nil.
+func FloatFromPtr(f *float64) Float {
+	return null.FloatFromPtr(f)
+}
+
+// Bool is a nullable bool. It is an alias of null.Bool.
+type Bool = null.Bool
+
+// NewBool creates a new Bool.
+func NewBool(b bool, valid bool) Bool {
+	return null.NewBool(b, valid)
+}
+
+// BoolFrom creates a new Bool that will always be valid.
+func BoolFrom(b bool) Bool {
+	return null.BoolFrom(b)
+}
+
+// BoolFromPtr creates a new Bool that will be null if b is nil.
+func BoolFromPtr(b *bool) Bool {
+	return null.BoolFromPtr(b)
+}
+
+// Time is a nullable time.Time. It is an alias of null.Time.
+type Time = null.Time
+
+// NewTime creates a new Time.
+func NewTime(t time.Time, valid bool) Time {
+	return null.NewTime(t, valid)
+}
+
+// TimeFrom creates a new Time that will always be valid.
+func TimeFrom(t time.Time) Time {
+	return null.TimeFrom(t)
+}
+
+// TimeFromPtr creates a new Time that will be null if t is nil.
+func TimeFromPtr(t *time.Time) Time {
+	return null.TimeFromPtr(t)
+}
@@ -0,0 +1,176 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/vitdevelop/null"
+)
+
+var (
+	intJSON       = []byte(`12345`)
+	intStringJSON = []byte(`"12345"`)
+	floatJSON     = []byte(`1.2345`)
+	boolJSON      = []byte(`true`)
+	stringJSON    = []byte(`"test"`)
+	timeString    = "2012-12-21T21:21:21Z"
+	timeJSON      = []byte(`"` + timeString + `"`)
+	timeValue, _  = time.Parse(time.RFC3339, timeString)
+	nullJSON      = []byte(`null`)
+)
+
+func TestIntFrom(t *testing.T) {
+	i := IntFrom(12345)
+	assertInt(t, i, "IntFrom()")
+
+	zero := IntFrom(0)
+	if !zero.Valid {
+		t.Error("IntFrom(0)", "is invalid, but should be valid")
+	}
+}
+
+func TestIntFromPtr(t *testing.T) {
+	n := int64(12345)
+	iptr := &n
+	i := IntFromPtr(iptr)
+	assertInt(t, i, "IntFromPtr()")
+
+	null := IntFromPtr(nil)
+	assertNullInt(t, null, "IntFromPtr(nil)")
+}
+
+func TestUnmarshalInt(t *testing.T) {
+	var i Int
+	err := json.Unmarshal(intJSON, &i)
+	maybePanic(err)
+	assertInt(t, i, "int json")
+
+	var si Int
+	err = json.Unmarshal(intStringJSON, &si)
+	maybePanic(err)
+	assertInt(t, si, "int string json")
+
+	var null Int
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullInt(t, null, "null json")
+}
+
+func TestMarshalInt(t *testing.T) {
+	i := IntFrom(12345)
+	data, err := json.Marshal(i)
+	maybePanic(err)
+	assertJSONEquals(t, data, "12345", "non-empty json marshal")
+
+	null := NewInt(0, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestIntIsCoreInt64(t *testing.T) {
+	var i Int = null.Int64From(12345)
+	assertInt(t, i, "core Int64 as Int")
+}
+
+func TestInt32(t *testing.T) {
+	i := Int32From(12345)
+	if i.Int32 != 12345 || !i.Valid {
+		t.Errorf("bad Int32From(): %v", i)
+	}
+	if NewInt32(1, false).Valid || Int32FromPtr(nil).Valid {
+		t.Error("null Int32 is valid, but should be invalid")
+	}
+}
+
+func TestFloat(t *testing.T) {
+	var f Float
+	err := json.Unmarshal(floatJSON, &f)
+	maybePanic(err)
+	if !f.Equal(FloatFrom(1.2345)) {
+		t.Errorf("bad float json: %v", f)
+	}
+
+	data, err := json.Marshal(NewFloat(0, false))
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null float json marshal")
+
+	v := 1.2345
+	if !FloatFromPtr(&v).Equal(f) {
+		t.Error("FloatFromPtr() should equal FloatFrom()")
+	}
+}
+
+func TestBool(t *testing.T) {
+	var b Bool
+	err := json.Unmarshal(boolJSON, &b)
+	maybePanic(err)
+	if !b.Equal(BoolFrom(true)) {
+		t.Errorf("bad bool json: %v", b)
+	}
+	if !BoolFrom(false).Valid {
+		t.Error("BoolFrom(false) is invalid, but should be valid")
+	}
+	if BoolFromPtr(nil).Valid || NewBool(true, false).Valid {
+		t.Error("null Bool is valid, but should be invalid")
+	}
+}
+
+func TestString(t *testing.T) {
+	var s String
+	err := json.Unmarshal(stringJSON, &s)
+	maybePanic(err)
+	if !s.Equal(StringFrom("test")) {
+		t.Errorf("bad string json: %v", s)
+	}
+	if !StringFrom("").Valid {
+		t.Error("StringFrom(\"\") is invalid, but should be valid")
+	}
+	str := "test"
+	if !StringFromPtr(&str).Equal(s) || StringFromPtr(nil).Valid || NewString("test", false).Valid {
+		t.Error("bad String constructor")
+	}
+}
+
+func TestTime(t *testing.T) {
+	var ti Time
+	err := json.Unmarshal(timeJSON, &ti)
+	maybePanic(err)
+	if !ti.ExactEqual(TimeFrom(timeValue)) {
+		t.Errorf("bad time json: %v", ti)
+	}
+	if !TimeFromPtr(&timeValue).Equal(ti) || TimeFromPtr(nil).Valid || NewTime(timeValue, false).Valid {
+		t.Error("bad Time constructor")
+	}
+}
+
+func assertInt(t *testing.T, i Int, from string) {
+	t.Helper()
+	if i.Int64 != 12345 {
+		t.Errorf("bad %s int: %d ≠ %d\n", from, i.Int64, 12345)
+	}
+	if !i.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullInt(t *testing.T, i Int, from string) {
+	t.Helper()
+	if i.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
+
+func assertJSONEquals(t *testing.T, data []byte, cmp string, from string) {
+	t.Helper()
+	if string(data) != cmp {
+		t.Errorf("bad %s data: %s ≠ %s\n", from, data, cmp)
+	}
+}
+
+func maybePanic(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,110 @@
+package null
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+)
+
+// nullBytes is a JSON null literal
+var nullBytes = []byte("null")
+
+// Value represents a value that may be null.
+// It supports SQL and JSON serialization, and will marshal to null if null.
+// It has no counterpart in github.com/vitdevelop/null; prefer the concrete types where one exists.
+type Value[T any] struct {
+	sql.Null[T]
+}
+
+// NewValue creates a new Value.
+func NewValue[T any](v T, valid bool) Value[T] {
+	return Value[T]{
+		Null: sql.Null[T]{
+			V:     v,
+			Valid: valid,
+		},
+	}
+}
+
+// ValueFrom creates a new Value that will always be valid.
+func ValueFrom[T any](v T) Value[T] {
+	return NewValue(v, true)
+}
+
+// ValueFromPtr creates a new Value that will be null if v is nil.
+func ValueFromPtr[T any](v *T) Value[T] {
+	if v == nil {
+		var zero T
+		return NewValue(zero, false)
+	}
+	return NewValue(*v, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (t Value[T]) ValueOrZero() T {
+	if !t.Valid {
+		var zero T
+		return zero
+	}
+	return t.V
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Value is null.
+func (t Value[T]) MarshalJSON() ([]byte, error) {
+	if !t.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t.V)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports null and any input that T itself can be unmarshaled from.
+func (t *Value[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, nullBytes) {
+		t.Valid = false
+		return nil
+	}
+
+	if err := json.Unmarshal(data, &t.V); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+
+	t.Valid = true
+	return nil
+}
+
+// SetValid changes this Value's value and sets it to be non-null.
+func (t *Value[T]) SetValid(v T) {
+	t.V = v
+	t.Valid = true
+}
+
+// Ptr returns a pointer to this Value's value, or a nil pointer if this Value is null.
+func (t Value[T]) Ptr() *T {
+	if !t.Valid {
+		return nil
+	}
+	return &t.V
+}
+
+// IsZero returns true for null Values, for potential future omitempty support.
+func (t Value[T]) IsZero() bool {
+	return !t.Valid
+}
+
+// Equal returns true if both Values have the same value or are both null.
+// Values are compared with T's Equal method if it has one (such as time.Time), otherwise with ==,
+// which panics if T is not comparable.
+func (t Value[T]) Equal(other Value[T]) bool {
+	return t.Valid == other.Valid && (!t.Valid || equal(t.V, other.V))
+}
+
+// equal compares a and b with their Equal method if available, otherwise with ==.
+func equal[T any](a, b T) bool {
+	if eq, ok := any(a).(interface{ Equal(T) bool }); ok {
+		return eq.Equal(b)
+	}
+	return any(a) == any(b)
+}
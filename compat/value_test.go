@@ -0,0 +1,103 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestValueFrom(t *testing.T) {
+	v := ValueFrom[int64](12345)
+	if v.V != 12345 || !v.Valid {
+		t.Errorf("bad ValueFrom(): %v", v)
+	}
+
+	zero := ValueFrom("")
+	if !zero.Valid {
+		t.Error("ValueFrom(\"\")", "is invalid, but should be valid")
+	}
+
+	n := 42
+	if p := ValueFromPtr(&n); p.V != 42 || !p.Valid {
+		t.Errorf("bad ValueFromPtr(): %v", p)
+	}
+	if ValueFromPtr[int](nil).Valid {
+		t.Error("ValueFromPtr(nil)", "is valid, but should be invalid")
+	}
+}
+
+func TestValueJSON(t *testing.T) {
+	type point struct{ X, Y int }
+
+	var v Value[point]
+	err := json.Unmarshal([]byte(`{"X":1,"Y":2}`), &v)
+	maybePanic(err)
+	if !v.Equal(ValueFrom(point{1, 2})) {
+		t.Errorf("bad value json: %v", v)
+	}
+
+	data, err := json.Marshal(v)
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"X":1,"Y":2}`, "value json marshal")
+
+	err = json.Unmarshal(nullJSON, &v)
+	maybePanic(err)
+	if v.Valid {
+		t.Error("null json", "is valid, but should be invalid")
+	}
+
+	data, err = json.Marshal(v)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null value json marshal")
+
+	var bad Value[int]
+	if err := json.Unmarshal(stringJSON, &bad); err == nil {
+		t.Error("expected error unmarshaling string into Value[int]")
+	}
+}
+
+func TestValueSQL(t *testing.T) {
+	var v Value[string]
+	err := v.Scan("test")
+	maybePanic(err)
+	if v.ValueOrZero() != "test" {
+		t.Errorf("bad scanned value: %v", v)
+	}
+
+	err = v.Scan(nil)
+	maybePanic(err)
+	if v.Valid || v.Ptr() != nil {
+		t.Error("scanned null", "is valid, but should be invalid")
+	}
+
+	dv, err := NewValue("test", false).Value()
+	maybePanic(err)
+	if dv != nil {
+		t.Errorf("bad driver value: %v ≠ nil", dv)
+	}
+	dv, err = ValueFrom("test").Value()
+	maybePanic(err)
+	if dv != driver.Value("test") {
+		t.Errorf("bad driver value: %v ≠ test", dv)
+	}
+}
+
+func TestValueEqual(t *testing.T) {
+	if !NewValue(1, false).Equal(NewValue(2, false)) {
+		t.Error("null Values should be equal")
+	}
+	if ValueFrom(1).Equal(NewValue(1, false)) {
+		t.Error("valid and null Values should not be equal")
+	}
+	other := timeValue.In(time.FixedZone("UTC+1", 60*60))
+	if !ValueFrom(timeValue).Equal(ValueFrom(other)) {
+		t.Error("times in different locations should be equal")
+	}
+
+	var v Value[int]
+	v.SetValid(5)
+	if !v.Equal(ValueFrom(5)) || v.IsZero() {
+		t.Errorf("bad SetValid(): %v", v)
+	}
+}
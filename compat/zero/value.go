@@ -0,0 +1,107 @@
+package zero
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// Value represents a value that may be null.
+// JSON marshals to the zero value of T if null.
+// Considered null to SQL if zero.
+// It has no counterpart in github.com/vitdevelop/null/zero; prefer the concrete types where one exists.
+type Value[T any] struct {
+	sql.Null[T]
+}
+
+// NewValue creates a new Value.
+func NewValue[T any](v T, valid bool) Value[T] {
+	return Value[T]{
+		Null: sql.Null[T]{
+			V:     v,
+			Valid: valid,
+		},
+	}
+}
+
+// ValueFrom creates a new Value that will be null if v is the zero value of T.
+func ValueFrom[T any](v T) Value[T] {
+	return NewValue(v, !isZero(v))
+}
+
+// ValueFromPtr creates a new Value that will be null if v is nil or *v is the zero value of T.
+func ValueFromPtr[T any](v *T) Value[T] {
+	if v == nil {
+		var zero T
+		return NewValue(zero, false)
+	}
+	return ValueFrom(*v)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (t Value[T]) ValueOrZero() T {
+	if !t.Valid {
+		var zero T
+		return zero
+	}
+	return t.V
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode the zero value of T if this Value is null.
+func (t Value[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.ValueOrZero())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports null and any input that T itself can be unmarshaled from.
+// Input that decodes to the zero value of T produces a null Value.
+func (t *Value[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		t.Valid = false
+		return nil
+	}
+
+	if err := json.Unmarshal(data, &t.V); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	}
+
+	t.Valid = !isZero(t.V)
+	return nil
+}
+
+// SetValid changes this Value's value and sets it to be non-null.
+func (t *Value[T]) SetValid(v T) {
+	t.V = v
+	t.Valid = true
+}
+
+// Ptr returns a pointer to this Value's value, or a nil pointer if this Value is null.
+func (t Value[T]) Ptr() *T {
+	if !t.Valid {
+		return nil
+	}
+	return &t.V
+}
+
+// IsZero returns true for null or zero Values, for potential future omitempty support.
+func (t Value[T]) IsZero() bool {
+	return !t.Valid || isZero(t.V)
+}
+
+// Equal returns true if both Values have the same value or are both either null or zero.
+// Values are compared with T's Equal method if it has one (such as time.Time), otherwise with ==,
+// which panics if T is not comparable.
+func (t Value[T]) Equal(other Value[T]) bool {
+	a, b := t.ValueOrZero(), other.ValueOrZero()
+	if eq, ok := any(a).(interface{ Equal(T) bool }); ok {
+		return eq.Equal(b)
+	}
+	return any(a) == any(b)
+}
+
+// isZero reports whether v is the zero value of its type.
+func isZero[T any](v T) bool {
+	return reflect.ValueOf(&v).Elem().IsZero()
+}
@@ -0,0 +1,118 @@
+// Package zero provides the API of gopkg.in/guregu/null/zero on top of github.com/vitdevelop/null/zero,
+// so code written against upstream can switch import paths without renaming identifiers.
+// The named types are aliases of this module's types, so values can be passed between both packages freely.
+package zero
+
+import (
+	"time"
+
+	"github.com/vitdevelop/null/zero"
+)
+
+// String is a nullable string. It is an alias of zero.String.
+type String = zero.String
+
+// NewString creates a new String.
+func NewString(s string, valid bool) String {
+	return zero.NewString(s, valid)
+}
+
+// StringFrom creates a new String that will be null if blank.
+func StringFrom(s string) String {
+	return zero.StringFrom(s)
+}
+
+// StringFromPtr creates a new String that will be null if s is nil or blank.
+func StringFromPtr(s *string) String {
+	return zero.StringFromPtr(s)
+}
+
+// Int is a nullable int64. It is an alias of zero.Int64.
+type Int = zero.Int64
+
+// NewInt creates a new Int.
+func NewInt(i int64, valid bool) Int {
+	return zero.NewInt64(i, valid)
+}
+
+// IntFrom creates a new Int that will be null if zero.
+func IntFrom(i int64) Int {
+	return zero.Int64From(i)
+}
+
+// IntFromPtr creates a new Int that will be null if i is nil.
+func IntFromPtr(i *int64) Int {
+	return zero.Int64FromPtr(i)
+}
+
+// Int32 is a nullable int32. It is an alias of zero.Int32.
+type Int32 = zero.Int32
+
+// NewInt32 creates a new Int32.
+func NewInt32(i int32, valid bool) Int32 {
+	return zero.NewInt32(i, valid)
+}
+
+// Int32From creates a new Int32 that will be null if zero.
+func Int32From(i int32) Int32 {
+	return zero.Int32From(i)
+}
+
+// Int32FromPtr creates a new Int32 that will be null if i is nil.
+func Int32FromPtr(i *int32) Int32 {
+	return zero.Int32FromPtr(i)
+}
+
+// Float is a nullable float64. It is an alias of zero.Float.
+type Float = zero.Float
+
+// NewFloat creates a new Float.
+func NewFloat(f float64, valid bool) Float {
+	return zero.NewFloat(f, valid)
+}
+
+// FloatFrom creates a new Float that will be null if zero.
+func FloatFrom(f float64) Float {
+	return zero.FloatFrom(f)
+}
+
+// FloatFromPtr creates a new Float that will be null if f is nil.
+func FloatFromPtr(f *float64) Float {
+	return zero.FloatFromPtr(f)
+}
+
+// Bool is a nullable bool. It is an alias of zero.Bool.
+type Bool = zero.Bool
+
+// NewBool creates a new Bool.
+func NewBool(b bool, valid bool) Bool {
+	return zero.NewBool(b, valid)
+}
+
+// BoolFrom creates a new Bool that will be null if false.
+func BoolFrom(b bool) Bool {
+	return zero.BoolFrom(b)
+}
+
+// BoolFromPtr creates a new Bool that will be null if b is nil.
+func BoolFromPtr(b *bool) Bool {
+	return zero.BoolFromPtr(b)
+}
+
+// Time is a nullable time.Time. It is an alias of zero.Time.
+type Time = zero.Time
+
+// NewTime creates a new Time.
+func NewTime(t time.Time, valid bool) Time {
+	return zero.NewTime(t, valid)
+}
+
+// TimeFrom creates a new Time that will be null if t is the zero value.
+func TimeFrom(t time.Time) Time {
+	return zero.TimeFrom(t)
+}
+
+// TimeFromPtr creates a new Time that will be null if t is nil or *t is the zero value.
+func TimeFromPtr(t *time.Time) Time {
+	return zero.TimeFromPtr(t)
+}
@@ -0,0 +1,139 @@
+package zero
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+var (
+	intJSON      = []byte(`12345`)
+	zeroJSON     = []byte(`0`)
+	nullJSON     = []byte(`null`)
+	timeString   = "2012-12-21T21:21:21Z"
+	timeValue, _ = time.Parse(time.RFC3339, timeString)
+)
+
+func TestIntFrom(t *testing.T) {
+	i := IntFrom(12345)
+	assertInt(t, i, "IntFrom()")
+
+	zero := IntFrom(0)
+	if zero.Valid {
+		t.Error("IntFrom(0)", "is valid, but should be invalid")
+	}
+}
+
+func TestIntFromPtr(t *testing.T) {
+	n := int64(12345)
+	iptr := &n
+	i := IntFromPtr(iptr)
+	assertInt(t, i, "IntFromPtr()")
+
+	null := IntFromPtr(nil)
+	assertNullInt(t, null, "IntFromPtr(nil)")
+}
+
+func TestUnmarshalInt(t *testing.T) {
+	var i Int
+	err := json.Unmarshal(intJSON, &i)
+	maybePanic(err)
+	assertInt(t, i, "int json")
+
+	var zero Int
+	err = json.Unmarshal(zeroJSON, &zero)
+	maybePanic(err)
+	assertNullInt(t, zero, "zero json")
+
+	var null Int
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullInt(t, null, "null json")
+}
+
+func TestMarshalInt(t *testing.T) {
+	i := IntFrom(12345)
+	data, err := json.Marshal(i)
+	maybePanic(err)
+	assertJSONEquals(t, data, "12345", "non-empty json marshal")
+
+	null := NewInt(0, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "0", "null json marshal")
+}
+
+func TestConstructors(t *testing.T) {
+	if Int32From(0).Valid || FloatFrom(0).Valid || BoolFrom(false).Valid || StringFrom("").Valid || TimeFrom(time.Time{}).Valid {
+		t.Error("zero values should be invalid")
+	}
+	if !Int32From(1).Valid || !FloatFrom(1).Valid || !BoolFrom(true).Valid || !StringFrom("test").Valid || !TimeFrom(timeValue).Valid {
+		t.Error("non-zero values should be valid")
+	}
+	blank := ""
+	if StringFromPtr(&blank).Valid || TimeFromPtr(&time.Time{}).Valid {
+		t.Error("pointers to zero values should be invalid")
+	}
+	if NewString("test", false).Valid || NewTime(timeValue, false).Valid {
+		t.Error("null values should be invalid")
+	}
+}
+
+func TestValue(t *testing.T) {
+	v := ValueFrom(12345)
+	if !v.Valid || v.V != 12345 {
+		t.Errorf("bad ValueFrom(): %v", v)
+	}
+	if ValueFrom(0).Valid || ValueFromPtr[string](nil).Valid {
+		t.Error("zero Value is valid, but should be invalid")
+	}
+
+	data, err := json.Marshal(NewValue("test", false))
+	maybePanic(err)
+	assertJSONEquals(t, data, `""`, "null value json marshal")
+
+	var zero Value[float64]
+	err = json.Unmarshal(zeroJSON, &zero)
+	maybePanic(err)
+	if zero.Valid || !zero.IsZero() {
+		t.Error("zero json", "is valid, but should be invalid")
+	}
+
+	if !NewValue(0, true).Equal(NewValue(5, false)) {
+		t.Error("zero and null Values should be equal")
+	}
+	other := timeValue.In(time.FixedZone("UTC+1", 60*60))
+	if !ValueFrom(timeValue).Equal(ValueFrom(other)) {
+		t.Error("times in different locations should be equal")
+	}
+}
+
+func assertInt(t *testing.T, i Int, from string) {
+	t.Helper()
+	if i.Int64 != 12345 {
+		t.Errorf("bad %s int: %d ≠ %d\n", from, i.Int64, 12345)
+	}
+	if !i.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullInt(t *testing.T, i Int, from string) {
+	t.Helper()
+	if i.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
+
+func assertJSONEquals(t *testing.T, data []byte, cmp string, from string) {
+	t.Helper()
+	if string(data) != cmp {
+		t.Errorf("bad %s data: %s ≠ %s\n", from, data, cmp)
+	}
+}
+
+func maybePanic(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,131 @@
+package null
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ErrPrecisionLoss is returned by conversion methods when the value can't be represented exactly
+// in the target type, such as a fractional Float converted with RoundExact.
+var ErrPrecisionLoss = errors.New("null: conversion loses precision")
+
+// RoundingMode controls how Float.ToInt64 and Float.ToInt32 handle fractional values.
+type RoundingMode int
+
+const (
+	// RoundExact rejects fractional values with ErrPrecisionLoss.
+	RoundExact RoundingMode = iota
+	// RoundTruncate rounds towards zero.
+	RoundTruncate
+	// RoundNearest rounds to the nearest integer, with halves rounded away from zero.
+	RoundNearest
+	// RoundNearestEven rounds to the nearest integer, with halves rounded to the even integer.
+	RoundNearestEven
+	// RoundFloor rounds towards negative infinity.
+	RoundFloor
+	// RoundCeil rounds towards positive infinity.
+	RoundCeil
+)
+
+// round rounds f according to mode.
+func (mode RoundingMode) round(f float64) (float64, error) {
+	switch mode {
+	case RoundExact:
+		if f != math.Trunc(f) {
+			return 0, fmt.Errorf("null: %v is not an integer: %w", f, ErrPrecisionLoss)
+		}
+		return f, nil
+	case RoundTruncate:
+		return math.Trunc(f), nil
+	case RoundNearest:
+		return math.Round(f), nil
+	case RoundNearestEven:
+		return math.RoundToEven(f), nil
+	case RoundFloor:
+		return math.Floor(f), nil
+	case RoundCeil:
+		return math.Ceil(f), nil
+	}
+	return 0, fmt.Errorf("null: invalid rounding mode %d", mode)
+}
+
+// ToInt64 converts this Int32 to an Int64. This conversion is always exact.
+func (i Int32) ToInt64() Int64 {
+	return NewInt64(int64(i.Int32), i.Valid)
+}
+
+// ToFloat converts this Int32 to a Float. This conversion is always exact.
+func (i Int32) ToFloat() Float {
+	return NewFloat(float64(i.Int32), i.Valid)
+}
+
+// ToInt32 converts this Int64 to an Int32, or null if this Int64 is null.
+// It returns ErrOverflow if the value doesn't fit in an int32.
+func (i Int64) ToInt32() (Int32, error) {
+	if !i.Valid {
+		return NewInt32(0, false), nil
+	}
+	if i.Int64 < math.MinInt32 || i.Int64 > math.MaxInt32 {
+		return NewInt32(0, false), fmt.Errorf("null: %d is out of range for int32: %w", i.Int64, ErrOverflow)
+	}
+	return Int32From(int32(i.Int64)), nil
+}
+
+// ToFloat converts this Int64 to a Float, or null if this Int64 is null.
+// It returns ErrPrecisionLoss if the value can't be represented exactly as a float64,
+// which can only happen when its magnitude is greater than 2^53.
+func (i Int64) ToFloat() (Float, error) {
+	if !i.Valid {
+		return NewFloat(0, false), nil
+	}
+	f := float64(i.Int64)
+	if f >= 1<<63 || int64(f) != i.Int64 {
+		return NewFloat(0, false), fmt.Errorf("null: %d can't be represented as float64: %w", i.Int64, ErrPrecisionLoss)
+	}
+	return FloatFrom(f), nil
+}
+
+// ToInt64 converts this Float to an Int64 using the given rounding mode, or null if this Float is null.
+// It returns ErrPrecisionLoss if mode is RoundExact and the value is fractional,
+// and ErrOverflow if the rounded value is NaN or doesn't fit in an int64.
+func (f Float) ToInt64(mode RoundingMode) (Int64, error) {
+	if !f.Valid {
+		return NewInt64(0, false), nil
+	}
+	n, err := f.toInt(mode, math.MinInt64, math.MaxInt64, "int64")
+	if err != nil {
+		return NewInt64(0, false), err
+	}
+	return Int64From(n), nil
+}
+
+// ToInt32 converts this Float to an Int32 using the given rounding mode, or null if this Float is null.
+// It returns ErrPrecisionLoss if mode is RoundExact and the value is fractional,
+// and ErrOverflow if the rounded value is NaN or doesn't fit in an int32.
+func (f Float) ToInt32(mode RoundingMode) (Int32, error) {
+	if !f.Valid {
+		return NewInt32(0, false), nil
+	}
+	n, err := f.toInt(mode, math.MinInt32, math.MaxInt32, "int32")
+	if err != nil {
+		return NewInt32(0, false), err
+	}
+	return Int32From(int32(n)), nil
+}
+
+// toInt rounds this Float's value and checks that it lies within [lo, hi].
+func (f Float) toInt(mode RoundingMode, lo, hi int64, typ string) (int64, error) {
+	if math.IsNaN(f.Float64) || math.IsInf(f.Float64, 0) {
+		return 0, fmt.Errorf("null: %v is out of range for %s: %w", f.Float64, typ, ErrOverflow)
+	}
+	r, err := mode.round(f.Float64)
+	if err != nil {
+		return 0, err
+	}
+	// float64(hi)+1 is a power of two: exact for int32, and float64(hi) already rounds up to it for int64
+	if r < float64(lo) || r >= float64(hi)+1 {
+		return 0, fmt.Errorf("null: %v is out of range for %s: %w", f.Float64, typ, ErrOverflow)
+	}
+	return int64(r), nil
+}
@@ -2,8 +2,11 @@ package null
 
 import (
 	"bytes"
+	"cmp"
 	"database/sql"
+	"encoding/binary"
 	"encoding/json"
+	"encoding/xml"
 	"errors"
 	"fmt"
 	"math"
@@ -49,6 +52,23 @@ func (f Float) ValueOrZero() float64 {
 	return f.Float64
 }
 
+// OrElse returns the inner value if valid, otherwise v.
+func (f Float) OrElse(v float64) float64 {
+	if !f.Valid {
+		return v
+	}
+	return f.Float64
+}
+
+// OrElseGet returns the inner value if valid, otherwise the result of calling fn.
+// fn is only called if this Float is null.
+func (f Float) OrElseGet(fn func() float64) float64 {
+	if !f.Valid {
+		return fn()
+	}
+	return f.Float64
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 // It supports number and null input.
 // 0 will not be considered a null Float.
@@ -58,6 +78,12 @@ func (f *Float) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
+	if n, ok := parseJSONFloat(data); ok {
+		f.Float64 = n
+		f.Valid = true
+		return nil
+	}
+
 	if err := json.Unmarshal(data, &f.Float64); err != nil {
 		var typeError *json.UnmarshalTypeError
 		if errors.As(err, &typeError) {
@@ -103,27 +129,123 @@ func (f *Float) UnmarshalText(text []byte) error {
 }
 
 // MarshalJSON implements json.Marshaler.
-// It will encode null if this Float is null.
+// It will encode null if this Float is null, and return an error for NaN and infinity;
+// see FloatNaNAsNull and FloatNaNAsString to encode them instead.
 func (f Float) MarshalJSON() ([]byte, error) {
+	return f.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of f to dst, the same as MarshalJSON.
+// It returns an error for NaN and infinity.
+func (f Float) AppendJSON(dst []byte) ([]byte, error) {
 	if !f.Valid {
-		return []byte("null"), nil
+		return append(dst, nullBytes...), nil
 	}
-	if math.IsInf(f.Float64, 0) || math.IsNaN(f.Float64) {
-		return nil, &json.UnsupportedValueError{
-			Value: reflect.ValueOf(f.Float64),
-			Str:   strconv.FormatFloat(f.Float64, 'g', -1, 64),
-		}
+	if isNonFinite(f.Float64) {
+		return dst, unsupportedFloatError(f.Float64)
 	}
-	return []byte(strconv.FormatFloat(f.Float64, 'f', -1, 64)), nil
+	return strconv.AppendFloat(dst, f.Float64, 'f', -1, 64), nil
 }
 
 // MarshalText implements encoding.TextMarshaler.
-// It will encode a blank string if this Float is null.
+// It will encode a blank string if this Float is null, and return an error for NaN and infinity, like MarshalJSON;
+// see FloatNaNAsNull and FloatNaNAsString to encode them instead.
 func (f Float) MarshalText() ([]byte, error) {
+	return f.AppendText([]byte{})
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append nothing if this Float is null, and return an error for NaN and infinity.
+func (f Float) AppendText(dst []byte) ([]byte, error) {
+	if !f.Valid {
+		return dst, nil
+	}
+	if isNonFinite(f.Float64) {
+		return dst, unsupportedFloatError(f.Float64)
+	}
+	return strconv.AppendFloat(dst, f.Float64, 'f', -1, 64), nil
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode null as an empty element; see XMLOmit and XMLNil for other encodings.
+func (f Float) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, xmlNullEmpty, !f.Valid, f)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// It will unmarshal to a null Float if the element is empty or has xsi:nil="true".
+func (f *Float) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, f)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will encode null as an empty attribute; see XMLOmit and XMLNil to omit it.
+func (f Float) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, xmlNullEmpty, !f.Valid, f)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+// It will unmarshal to a null Float if the attribute is empty.
+func (f *Float) UnmarshalXMLAttr(attr xml.Attr) error {
+	return f.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It encodes a version byte and a validity byte; valid values are followed by the IEEE 754 bits of the value as 8 big-endian bytes.
+func (f Float) MarshalBinary() ([]byte, error) {
+	if !f.Valid {
+		return binaryNull(), nil
+	}
+	return binary.BigEndian.AppendUint64(binaryHeader(8), math.Float64bits(f.Float64)), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// It decodes the encoding produced by MarshalBinary.
+func (f *Float) UnmarshalBinary(data []byte) error {
+	value, valid, err := readBinary(data, 8)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		*f = NewFloat(0, false)
+		return nil
+	}
+	*f = NewFloat(math.Float64frombits(binary.BigEndian.Uint64(value)), true)
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using MarshalBinary.
+func (f Float) GobEncode() ([]byte, error) {
+	return f.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using UnmarshalBinary.
+func (f *Float) GobDecode(data []byte) error {
+	return f.UnmarshalBinary(data)
+}
+
+// MarshalYAML implements yaml.Marshaler from gopkg.in/yaml.v3 and compatible packages.
+// It will encode null if this Float is null.
+func (f Float) MarshalYAML() (any, error) {
 	if !f.Valid {
-		return []byte{}, nil
+		return nil, nil
 	}
-	return []byte(strconv.FormatFloat(f.Float64, 'f', -1, 64)), nil
+	return f.Float64, nil
+}
+
+// UnmarshalYAML implements the unmarshaler interface of gopkg.in/yaml.v2, which gopkg.in/yaml.v3 also supports.
+// It supports number and null input.
+func (f *Float) UnmarshalYAML(unmarshal func(any) error) error {
+	var v *float64
+	if err := unmarshal(&v); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal YAML: %w", err)
+	}
+	if v == nil {
+		*f = NewFloat(0, false)
+		return nil
+	}
+	*f = FloatFrom(*v)
+	return nil
 }
 
 // SetValid changes this Float's value and also sets it to be non-null.
@@ -154,3 +276,37 @@ func (f Float) IsZero() bool {
 func (f Float) Equal(other Float) bool {
 	return f.Valid == other.Valid && (!f.Valid || f.Float64 == other.Float64)
 }
+
+// Compare returns an integer comparing two Floats, for use with slices.SortFunc and similar.
+// The result is -1 if f < other, 0 if f == other, and +1 if f > other.
+// NaN is considered less than any other value, like cmp.Compare.
+// Null is considered less than any valid value; use NullsLast for the opposite order.
+func (f Float) Compare(other Float) int {
+	if !f.Valid || !other.Valid {
+		return compareBool(f.Valid, other.Valid)
+	}
+	return cmp.Compare(f.Float64, other.Float64)
+}
+
+func isNonFinite(f float64) bool {
+	return math.IsInf(f, 0) || math.IsNaN(f)
+}
+
+// unsupportedFloatError is the error for encoding NaN and infinity to JSON or text, the same as encoding/json's.
+func unsupportedFloatError(f float64) error {
+	return &json.UnsupportedValueError{
+		Value: reflect.ValueOf(f),
+		Str:   strconv.FormatFloat(f, 'g', -1, 64),
+	}
+}
+
+// appendNonFinite appends NaN, Infinity or -Infinity.
+func appendNonFinite(b []byte, f float64) []byte {
+	switch {
+	case math.IsNaN(f):
+		return append(b, "NaN"...)
+	case f > 0:
+		return append(b, "Infinity"...)
+	}
+	return append(b, "-Infinity"...)
+}
@@ -0,0 +1,152 @@
+package null
+
+import (
+	"encoding/xml"
+)
+
+// FloatNaNAsNull is a nullable float64 that encodes NaN and infinity the same as null:
+// null in JSON, a blank string in text, and an empty element or attribute in XML.
+// It behaves exactly like Float otherwise, which returns an error for NaN and infinity in JSON.
+type FloatNaNAsNull struct {
+	Float
+}
+
+// NewFloatNaNAsNull creates a new FloatNaNAsNull
+func NewFloatNaNAsNull(f float64, valid bool) FloatNaNAsNull {
+	return FloatNaNAsNull{Float: NewFloat(f, valid)}
+}
+
+// FloatNaNAsNullFrom creates a new FloatNaNAsNull that will always be valid.
+func FloatNaNAsNullFrom(f float64) FloatNaNAsNull {
+	return NewFloatNaNAsNull(f, true)
+}
+
+// FloatNaNAsNullFromPtr creates a new FloatNaNAsNull that will be null if f is nil.
+func FloatNaNAsNullFromPtr(f *float64) FloatNaNAsNull {
+	return FloatNaNAsNull{Float: FloatFromPtr(f)}
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this FloatNaNAsNull is null, NaN or infinite.
+func (f FloatNaNAsNull) MarshalJSON() ([]byte, error) {
+	return f.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of f to dst, the same as MarshalJSON.
+func (f FloatNaNAsNull) AppendJSON(dst []byte) ([]byte, error) {
+	if !f.Valid || isNonFinite(f.Float64) {
+		return append(dst, nullBytes...), nil
+	}
+	return f.Float.AppendJSON(dst)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this FloatNaNAsNull is null, NaN or infinite.
+func (f FloatNaNAsNull) MarshalText() ([]byte, error) {
+	return f.AppendText([]byte{})
+}
+
+// AppendText implements encoding.TextAppender.
+func (f FloatNaNAsNull) AppendText(dst []byte) ([]byte, error) {
+	if f.Valid && isNonFinite(f.Float64) {
+		return dst, nil
+	}
+	return f.Float.AppendText(dst)
+}
+
+// MarshalXML implements xml.Marshaler, using MarshalText.
+func (f FloatNaNAsNull) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, xmlNullEmpty, !f.Valid || isNonFinite(f.Float64), f)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr, using MarshalText.
+func (f FloatNaNAsNull) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, xmlNullEmpty, !f.Valid || isNonFinite(f.Float64), f)
+}
+
+// Equal returns true if both floats have the same value or are both null.
+func (f FloatNaNAsNull) Equal(other FloatNaNAsNull) bool {
+	return f.Float.Equal(other.Float)
+}
+
+// Compare returns an integer comparing two FloatNaNAsNulls, for use with slices.SortFunc and similar.
+// The result is -1 if f < other, 0 if f == other, and +1 if f > other.
+// Null is considered less than any valid value, and NaN less than any other valid value.
+func (f FloatNaNAsNull) Compare(other FloatNaNAsNull) int {
+	return f.Float.Compare(other.Float)
+}
+
+// FloatNaNAsString is a nullable float64 that encodes NaN and infinity as "NaN", "Infinity" and "-Infinity",
+// which are JSON strings in JSON, as used by JavaScript and many JSON libraries.
+// It behaves exactly like Float otherwise, which returns an error for NaN and infinity in JSON.
+// Float and FloatNaNAsString both decode these strings.
+type FloatNaNAsString struct {
+	Float
+}
+
+// NewFloatNaNAsString creates a new FloatNaNAsString
+func NewFloatNaNAsString(f float64, valid bool) FloatNaNAsString {
+	return FloatNaNAsString{Float: NewFloat(f, valid)}
+}
+
+// FloatNaNAsStringFrom creates a new FloatNaNAsString that will always be valid.
+func FloatNaNAsStringFrom(f float64) FloatNaNAsString {
+	return NewFloatNaNAsString(f, true)
+}
+
+// FloatNaNAsStringFromPtr creates a new FloatNaNAsString that will be null if f is nil.
+func FloatNaNAsStringFromPtr(f *float64) FloatNaNAsString {
+	return FloatNaNAsString{Float: FloatFromPtr(f)}
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this FloatNaNAsString is null, and NaN and infinity as JSON strings.
+func (f FloatNaNAsString) MarshalJSON() ([]byte, error) {
+	return f.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of f to dst, the same as MarshalJSON.
+func (f FloatNaNAsString) AppendJSON(dst []byte) ([]byte, error) {
+	if f.Valid && isNonFinite(f.Float64) {
+		dst = append(dst, '"')
+		dst = appendNonFinite(dst, f.Float64)
+		return append(dst, '"'), nil
+	}
+	return f.Float.AppendJSON(dst)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this FloatNaNAsString is null, and NaN and infinity as NaN, Infinity and -Infinity.
+func (f FloatNaNAsString) MarshalText() ([]byte, error) {
+	return f.AppendText([]byte{})
+}
+
+// AppendText implements encoding.TextAppender.
+func (f FloatNaNAsString) AppendText(dst []byte) ([]byte, error) {
+	if f.Valid && isNonFinite(f.Float64) {
+		return appendNonFinite(dst, f.Float64), nil
+	}
+	return f.Float.AppendText(dst)
+}
+
+// MarshalXML implements xml.Marshaler, using MarshalText.
+func (f FloatNaNAsString) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, xmlNullEmpty, !f.Valid, f)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr, using MarshalText.
+func (f FloatNaNAsString) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, xmlNullEmpty, !f.Valid, f)
+}
+
+// Equal returns true if both floats have the same value or are both null.
+func (f FloatNaNAsString) Equal(other FloatNaNAsString) bool {
+	return f.Float.Equal(other.Float)
+}
+
+// Compare returns an integer comparing two FloatNaNAsStrings, for use with slices.SortFunc and similar.
+// The result is -1 if f < other, 0 if f == other, and +1 if f > other.
+// Null is considered less than any valid value, and NaN less than any other valid value.
+func (f FloatNaNAsString) Compare(other FloatNaNAsString) int {
+	return f.Float.Compare(other.Float)
+}
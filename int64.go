@@ -2,8 +2,11 @@ package null
 
 import (
 	"bytes"
+	"cmp"
 	"database/sql"
+	"encoding/binary"
 	"encoding/json"
+	"encoding/xml"
 	"errors"
 	"fmt"
 	"strconv"
@@ -47,6 +50,23 @@ func (i Int64) ValueOrZero() int64 {
 	return i.Int64
 }
 
+// OrElse returns the inner value if valid, otherwise v.
+func (i Int64) OrElse(v int64) int64 {
+	if !i.Valid {
+		return v
+	}
+	return i.Int64
+}
+
+// OrElseGet returns the inner value if valid, otherwise the result of calling f.
+// f is only called if this Int64 is null.
+func (i Int64) OrElseGet(f func() int64) int64 {
+	if !i.Valid {
+		return f()
+	}
+	return i.Int64
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 // It supports number, string, and null input.
 // 0 will not be considered a null Int64.
@@ -56,6 +76,12 @@ func (i *Int64) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
+	if n, ok := parseJSONInt(data, 64); ok {
+		i.Int64 = n
+		i.Valid = true
+		return nil
+	}
+
 	if err := json.Unmarshal(data, &i.Int64); err != nil {
 		var typeError *json.UnmarshalTypeError
 		if errors.As(err, &typeError) {
@@ -103,19 +129,112 @@ func (i *Int64) UnmarshalText(text []byte) error {
 // MarshalJSON implements json.Marshaler.
 // It will encode null if this Int64 is null.
 func (i Int64) MarshalJSON() ([]byte, error) {
+	return i.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of i to dst, the same as MarshalJSON.
+func (i Int64) AppendJSON(dst []byte) ([]byte, error) {
 	if !i.Valid {
-		return []byte("null"), nil
+		return append(dst, nullBytes...), nil
 	}
-	return []byte(strconv.FormatInt(i.Int64, 10)), nil
+	return strconv.AppendInt(dst, i.Int64, 10), nil
 }
 
 // MarshalText implements encoding.TextMarshaler.
 // It will encode a blank string if this Int64 is null.
 func (i Int64) MarshalText() ([]byte, error) {
+	return i.AppendText([]byte{})
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append nothing if this Int64 is null.
+func (i Int64) AppendText(dst []byte) ([]byte, error) {
+	if !i.Valid {
+		return dst, nil
+	}
+	return strconv.AppendInt(dst, i.Int64, 10), nil
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode null as an empty element; see XMLOmit and XMLNil for other encodings.
+func (i Int64) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, xmlNullEmpty, !i.Valid, i)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// It will unmarshal to a null Int64 if the element is empty or has xsi:nil="true".
+func (i *Int64) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, i)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will encode null as an empty attribute; see XMLOmit and XMLNil to omit it.
+func (i Int64) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, xmlNullEmpty, !i.Valid, i)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+// It will unmarshal to a null Int64 if the attribute is empty.
+func (i *Int64) UnmarshalXMLAttr(attr xml.Attr) error {
+	return i.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It encodes a version byte and a validity byte; valid values are followed by the value as 8 big-endian bytes.
+func (i Int64) MarshalBinary() ([]byte, error) {
 	if !i.Valid {
-		return []byte{}, nil
+		return binaryNull(), nil
+	}
+	return binary.BigEndian.AppendUint64(binaryHeader(8), uint64(i.Int64)), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// It decodes the encoding produced by MarshalBinary.
+func (i *Int64) UnmarshalBinary(data []byte) error {
+	value, valid, err := readBinary(data, 8)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		*i = NewInt64(0, false)
+		return nil
 	}
-	return []byte(strconv.FormatInt(i.Int64, 10)), nil
+	*i = NewInt64(int64(binary.BigEndian.Uint64(value)), true)
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using MarshalBinary.
+func (i Int64) GobEncode() ([]byte, error) {
+	return i.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using UnmarshalBinary.
+func (i *Int64) GobDecode(data []byte) error {
+	return i.UnmarshalBinary(data)
+}
+
+// MarshalYAML implements yaml.Marshaler from gopkg.in/yaml.v3 and compatible packages.
+// It will encode null if this Int64 is null.
+func (i Int64) MarshalYAML() (any, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+	return i.Int64, nil
+}
+
+// UnmarshalYAML implements the unmarshaler interface of gopkg.in/yaml.v2, which gopkg.in/yaml.v3 also supports.
+// It supports integer and null input.
+func (i *Int64) UnmarshalYAML(unmarshal func(any) error) error {
+	var v *int64
+	if err := unmarshal(&v); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal YAML: %w", err)
+	}
+	if v == nil {
+		*i = NewInt64(0, false)
+		return nil
+	}
+	*i = Int64From(*v)
+	return nil
 }
 
 // SetValid changes this Int64's value and also sets it to be non-null.
@@ -142,3 +261,13 @@ func (i Int64) IsZero() bool {
 func (i Int64) Equal(other Int64) bool {
 	return i.Valid == other.Valid && (!i.Valid || i.Int64 == other.Int64)
 }
+
+// Compare returns an integer comparing two Int64s, for use with slices.SortFunc and similar.
+// The result is -1 if i < other, 0 if i == other, and +1 if i > other.
+// Null is considered less than any valid value; use NullsLast for the opposite order.
+func (i Int64) Compare(other Int64) int {
+	if !i.Valid || !other.Valid {
+		return compareBool(i.Valid, other.Valid)
+	}
+	return cmp.Compare(i.Int64, other.Int64)
+}
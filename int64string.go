@@ -0,0 +1,63 @@
+package null
+
+import (
+	"strconv"
+)
+
+// Int64String is a nullable int64 that encodes to a JSON string, such as "12345678901234567",
+// for JavaScript clients that can't represent integers above 2^53 exactly.
+// It behaves exactly like Int64 otherwise: UnmarshalJSON accepts both numbers and strings,
+// and SQL, text, XML and binary encoding are the same.
+//
+// The methods promoted from Int64, such as Add and Sub, take and return Int64.
+// Wrap their results in Int64String{...} before encoding them to JSON,
+// or they will be encoded as numbers:
+//
+//	sum, err := a.Add(b.Int64)
+//	total := Int64String{sum}
+type Int64String struct {
+	Int64
+}
+
+// NewInt64String creates a new Int64String
+func NewInt64String(i int64, valid bool) Int64String {
+	return Int64String{Int64: NewInt64(i, valid)}
+}
+
+// Int64StringFrom creates a new Int64String that will always be valid.
+func Int64StringFrom(i int64) Int64String {
+	return NewInt64String(i, true)
+}
+
+// Int64StringFromPtr creates a new Int64String that will be null if i is nil.
+func Int64StringFromPtr(i *int64) Int64String {
+	return Int64String{Int64: Int64FromPtr(i)}
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Int64String is null, and the number as a string otherwise.
+func (i Int64String) MarshalJSON() ([]byte, error) {
+	return i.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of i to dst, the same as MarshalJSON.
+func (i Int64String) AppendJSON(dst []byte) ([]byte, error) {
+	if !i.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	dst = append(dst, '"')
+	dst = strconv.AppendInt(dst, i.Int64.Int64, 10)
+	return append(dst, '"'), nil
+}
+
+// Equal returns true if both ints have the same value or are both null.
+func (i Int64String) Equal(other Int64String) bool {
+	return i.Int64.Equal(other.Int64)
+}
+
+// Compare returns an integer comparing two Int64Strings, for use with slices.SortFunc and similar.
+// The result is -1 if i < other, 0 if i == other, and +1 if i > other.
+// Null is considered less than any valid value.
+func (i Int64String) Compare(other Int64String) int {
+	return i.Int64.Compare(other.Int64)
+}
@@ -0,0 +1,129 @@
+package null
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestInt64StringFrom(t *testing.T) {
+	i := Int64StringFrom(12345)
+	assertInt64(t, i.Int64, "Int64StringFrom()")
+
+	n := int64(12345)
+	i = Int64StringFromPtr(&n)
+	assertInt64(t, i.Int64, "Int64StringFromPtr()")
+
+	null := Int64StringFromPtr(nil)
+	assertNullInt64(t, null.Int64, "Int64StringFromPtr(nil)")
+}
+
+func TestUnmarshalInt64String(t *testing.T) {
+	var i Int64String
+	err := json.Unmarshal(intJSON, &i)
+	maybePanic(err)
+	assertInt64(t, i.Int64, "int json")
+
+	var si Int64String
+	err = json.Unmarshal(intStringJSON, &si)
+	maybePanic(err)
+	assertInt64(t, si.Int64, "int string json")
+
+	var null Int64String
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullInt64(t, null.Int64, "null json")
+
+	var badType Int64String
+	err = json.Unmarshal(boolJSON, &badType)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullInt64(t, badType.Int64, "wrong type json")
+
+	var text Int64String
+	err = text.UnmarshalText([]byte("12345"))
+	maybePanic(err)
+	assertInt64(t, text.Int64, "UnmarshalText()")
+}
+
+func TestMarshalInt64String(t *testing.T) {
+	i := Int64StringFrom(12345678901234567)
+	data, err := json.Marshal(i)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"12345678901234567"`, "non-empty json marshal")
+
+	data, err = json.Marshal(Int64StringFrom(math.MinInt64))
+	maybePanic(err)
+	assertJSONEquals(t, data, `"-9223372036854775808"`, "min json marshal")
+
+	null := NewInt64String(0, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	// text is the same as Int64
+	data, err = i.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "12345678901234567", "non-empty text marshal")
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+
+	data, err = i.AppendJSON([]byte("prefix"))
+	maybePanic(err)
+	assertJSONEquals(t, data, `prefix"12345678901234567"`, "AppendJSON()")
+}
+
+func TestInt64StringRoundTrip(t *testing.T) {
+	type record struct {
+		ID     Int64String `json:"id"`
+		Parent Int64String `json:"parent"`
+	}
+	in := record{ID: Int64StringFrom(math.MaxInt64)}
+	data, err := json.Marshal(in)
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"id":"9223372036854775807","parent":null}`, "struct json marshal")
+
+	var out record
+	err = json.Unmarshal(data, &out)
+	maybePanic(err)
+	if out != in {
+		t.Errorf("round trip: got %v, want %v", out, in)
+	}
+}
+
+func TestInt64StringScan(t *testing.T) {
+	var i Int64String
+	err := i.Scan(12345)
+	maybePanic(err)
+	assertInt64(t, i.Int64, "scanned int")
+
+	v, err := i.Value()
+	maybePanic(err)
+	if v != int64(12345) {
+		t.Errorf("Value(): got %v, want 12345", v)
+	}
+
+	var null Int64String
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullInt64(t, null.Int64, "scanned null")
+}
+
+func TestInt64StringEqualCompare(t *testing.T) {
+	a, b, null := Int64StringFrom(1), Int64StringFrom(2), NewInt64String(0, false)
+	if !a.Equal(Int64StringFrom(1)) || a.Equal(b) || a.Equal(null) || !null.Equal(NewInt64String(1, false)) {
+		t.Error("Int64String.Equal() returned the wrong result")
+	}
+	if a.Compare(b) != -1 || b.Compare(a) != 1 || a.Compare(a) != 0 || null.Compare(a) != -1 {
+		t.Error("Int64String.Compare() returned the wrong result")
+	}
+
+	// arithmetic results are Int64 and must be wrapped again to encode as strings
+	sum, err := a.Add(b.Int64)
+	maybePanic(err)
+	data, err := json.Marshal(Int64String{sum})
+	maybePanic(err)
+	assertJSONEquals(t, data, `"3"`, "wrapped sum")
+}
@@ -0,0 +1,247 @@
+//go:build goexperiment.jsonv2
+
+package null
+
+import (
+	"encoding/json/jsontext"
+	"encoding/json/v2"
+	"time"
+	"unicode/utf8"
+)
+
+// This file implements the streaming methods of encoding/json/v2, which is built by default since Go 1.27,
+// and with GOEXPERIMENT=jsonv2 in Go 1.25 and 1.26.
+// They produce and accept the same JSON as MarshalJSON and UnmarshalJSON.
+// Marshaling avoids allocations in the common cases, and unmarshaling uses the fast paths of UnmarshalJSON.
+
+// MarshalJSONTo implements json.MarshalerTo from encoding/json/v2.
+// It will encode null if this Int64 is null.
+func (i Int64) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !i.Valid {
+		return enc.WriteToken(jsontext.Null)
+	}
+	return enc.WriteToken(jsontext.Int(i.Int64))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom from encoding/json/v2.
+// It supports number, string, and null input, like UnmarshalJSON.
+func (i *Int64) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	data, err := dec.ReadValue()
+	if err != nil {
+		return err
+	}
+	return i.UnmarshalJSON(data)
+}
+
+// MarshalJSONTo implements json.MarshalerTo from encoding/json/v2.
+// It will encode null if this Int64String is null, and the number as a string otherwise.
+func (i Int64String) MarshalJSONTo(enc *jsontext.Encoder) error {
+	var buf [32]byte
+	b, _ := i.AppendJSON(buf[:0])
+	return enc.WriteValue(b)
+}
+
+// MarshalJSONTo implements json.MarshalerTo from encoding/json/v2.
+// It will encode null if this Int32 is null.
+func (i Int32) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !i.Valid {
+		return enc.WriteToken(jsontext.Null)
+	}
+	return enc.WriteToken(jsontext.Int(int64(i.Int32)))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom from encoding/json/v2.
+// It supports number, string, and null input, like UnmarshalJSON.
+func (i *Int32) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	data, err := dec.ReadValue()
+	if err != nil {
+		return err
+	}
+	return i.UnmarshalJSON(data)
+}
+
+// MarshalJSONTo implements json.MarshalerTo from encoding/json/v2.
+// It will encode null if this Float is null, and return an error for NaN and infinity, like MarshalJSON.
+func (f Float) MarshalJSONTo(enc *jsontext.Encoder) error {
+	var buf [64]byte
+	b, err := f.AppendJSON(buf[:0])
+	if err != nil {
+		return err
+	}
+	return enc.WriteValue(b)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom from encoding/json/v2.
+// It supports number, string, and null input, like UnmarshalJSON.
+func (f *Float) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	data, err := dec.ReadValue()
+	if err != nil {
+		return err
+	}
+	return f.UnmarshalJSON(data)
+}
+
+// MarshalJSONTo implements json.MarshalerTo from encoding/json/v2.
+// It will encode null if this FloatNaNAsNull is null, NaN or infinite, like MarshalJSON.
+func (f FloatNaNAsNull) MarshalJSONTo(enc *jsontext.Encoder) error {
+	var buf [64]byte
+	b, _ := f.AppendJSON(buf[:0])
+	return enc.WriteValue(b)
+}
+
+// MarshalJSONTo implements json.MarshalerTo from encoding/json/v2.
+// It will encode null if this FloatNaNAsString is null, and NaN and infinity as JSON strings, like MarshalJSON.
+func (f FloatNaNAsString) MarshalJSONTo(enc *jsontext.Encoder) error {
+	var buf [64]byte
+	b, _ := f.AppendJSON(buf[:0])
+	return enc.WriteValue(b)
+}
+
+// MarshalJSONTo implements json.MarshalerTo from encoding/json/v2.
+// It will encode null if this Bool is null.
+func (b Bool) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !b.Valid {
+		return enc.WriteToken(jsontext.Null)
+	}
+	return enc.WriteToken(jsontext.Bool(b.Bool))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom from encoding/json/v2.
+// It supports boolean and null input, like UnmarshalJSON.
+func (b *Bool) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	data, err := dec.ReadValue()
+	if err != nil {
+		return err
+	}
+	return b.UnmarshalJSON(data)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom from encoding/json/v2.
+// It supports boolean, null, the numbers 0 and 1, and string input, like UnmarshalJSON.
+func (b *LenientBool) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	data, err := dec.ReadValue()
+	if err != nil {
+		return err
+	}
+	return b.UnmarshalJSON(data)
+}
+
+// MarshalJSONTo implements json.MarshalerTo from encoding/json/v2.
+// It will encode null if this String is null.
+// Characters are escaped according to the options of enc, so HTML characters are only escaped
+// when called by encoding/json, but invalid UTF-8 is always replaced, like MarshalJSON.
+func (s String) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !s.Valid || !utf8.ValidString(s.String) {
+		return marshalJSONTo(enc, s)
+	}
+	return enc.WriteToken(jsontext.String(s.String))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom from encoding/json/v2.
+// It supports string and null input, like UnmarshalJSON.
+func (s *String) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	data, err := dec.ReadValue()
+	if err != nil {
+		return err
+	}
+	return s.UnmarshalJSON(data)
+}
+
+// MarshalJSONTo implements json.MarshalerTo from encoding/json/v2.
+// It will encode null if this time is null.
+func (t Time) MarshalJSONTo(enc *jsontext.Encoder) error {
+	var buf [64]byte
+	b, err := t.AppendJSON(buf[:0])
+	if err != nil {
+		return err
+	}
+	return enc.WriteValue(b)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom from encoding/json/v2.
+// It supports string and null input, like UnmarshalJSON.
+func (t *Time) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	data, err := dec.ReadValue()
+	if err != nil {
+		return err
+	}
+	return t.UnmarshalJSON(data)
+}
+
+// MarshalJSONTo implements json.MarshalerTo from encoding/json/v2.
+// It will encode null if this time is null, and milliseconds since the Unix epoch otherwise.
+func (t Timestamp) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !t.Valid {
+		return enc.WriteToken(jsontext.Null)
+	}
+	return enc.WriteToken(jsontext.Int(t.Time.UnixNano() / int64(time.Millisecond)))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom from encoding/json/v2.
+// It supports number, string and null input, like UnmarshalJSON.
+func (t *Timestamp) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	data, err := dec.ReadValue()
+	if err != nil {
+		return err
+	}
+	return t.UnmarshalJSON(data)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom from encoding/json/v2.
+// It supports integer and null input, like UnmarshalJSON.
+func (i *StrictInt64) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	data, err := dec.ReadValue()
+	if err != nil {
+		return err
+	}
+	return i.UnmarshalJSON(data)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom from encoding/json/v2.
+// It supports integer and null input, like UnmarshalJSON.
+func (i *StrictInt32) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	data, err := dec.ReadValue()
+	if err != nil {
+		return err
+	}
+	return i.UnmarshalJSON(data)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom from encoding/json/v2.
+// It supports number and null input, like UnmarshalJSON.
+func (f *StrictFloat) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	data, err := dec.ReadValue()
+	if err != nil {
+		return err
+	}
+	return f.UnmarshalJSON(data)
+}
+
+// marshalJSONTo writes the result of v.MarshalJSON to enc.
+func marshalJSONTo(enc *jsontext.Encoder, v interface{ MarshalJSON() ([]byte, error) }) error {
+	data, err := v.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	return enc.WriteValue(data)
+}
+
+// MarshalJSONTo implements json.MarshalerTo from encoding/json/v2, using the wrapped value.
+func (x XMLOmit[T]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return json.MarshalEncode(enc, x.V)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom from encoding/json/v2, using the wrapped value.
+func (x *XMLOmit[T]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return json.UnmarshalDecode(dec, &x.V)
+}
+
+// MarshalJSONTo implements json.MarshalerTo from encoding/json/v2, using the wrapped value.
+func (x XMLNil[T]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return json.MarshalEncode(enc, x.V)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom from encoding/json/v2, using the wrapped value.
+func (x *XMLNil[T]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return json.UnmarshalDecode(dec, &x.V)
+}
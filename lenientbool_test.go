@@ -0,0 +1,107 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestLenientBoolFrom(t *testing.T) {
+	b := LenientBoolFrom(true)
+	assertBool(t, b.Bool, "LenientBoolFrom()")
+
+	zero := LenientBoolFrom(false)
+	assertFalseBool(t, zero.Bool, "LenientBoolFrom(false)")
+}
+
+func TestUnmarshalLenientBool(t *testing.T) {
+	for _, input := range []string{`true`, `1`, `"1"`, `"TRUE"`, `"yes"`, `"On"`, `"t"`} {
+		var b LenientBool
+		err := json.Unmarshal([]byte(input), &b)
+		maybePanic(err)
+		assertBool(t, b.Bool, "lenient bool json "+input)
+	}
+
+	for _, input := range []string{`false`, `0`, `"0"`, `"False"`, `"no"`, `"OFF"`, `"f"`} {
+		var b LenientBool
+		err := json.Unmarshal([]byte(input), &b)
+		maybePanic(err)
+		assertFalseBool(t, b.Bool, "lenient bool json "+input)
+	}
+
+	var null LenientBool
+	err := json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullBool(t, null.Bool, "null json")
+
+	var blank LenientBool
+	err = json.Unmarshal(blankStringJSON, &blank)
+	maybePanic(err)
+	assertNullBool(t, blank.Bool, "blank string json")
+
+	var badNumber LenientBool
+	err = json.Unmarshal([]byte(`2`), &badNumber)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullBool(t, badNumber.Bool, "wrong number json")
+
+	var badString LenientBool
+	err = json.Unmarshal([]byte(`"maybe"`), &badString)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullBool(t, badString.Bool, "wrong string json")
+
+	var invalid LenientBool
+	err = invalid.UnmarshalJSON(invalidJSON)
+	var syntaxError *json.SyntaxError
+	if !errors.As(err, &syntaxError) {
+		t.Errorf("expected wrapped json.SyntaxError, not %T", err)
+	}
+}
+
+func TestTextUnmarshalLenientBool(t *testing.T) {
+	for _, input := range []string{"true", "1", "T", "Yes", "on"} {
+		var b LenientBool
+		err := b.UnmarshalText([]byte(input))
+		maybePanic(err)
+		assertBool(t, b.Bool, "UnmarshalText() "+input)
+	}
+
+	for _, input := range []string{"false", "0", "F", "NO", "off"} {
+		var b LenientBool
+		err := b.UnmarshalText([]byte(input))
+		maybePanic(err)
+		assertFalseBool(t, b.Bool, "UnmarshalText() "+input)
+	}
+
+	var blank LenientBool
+	err := blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullBool(t, blank.Bool, "UnmarshalText() empty bool")
+
+	var null LenientBool
+	err = null.UnmarshalText([]byte("null"))
+	maybePanic(err)
+	assertNullBool(t, null.Bool, `UnmarshalText() "null"`)
+
+	var invalid LenientBool
+	err = invalid.UnmarshalText([]byte(":D"))
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullBool(t, invalid.Bool, "invalid text")
+}
+
+func TestMarshalLenientBool(t *testing.T) {
+	b := LenientBoolFrom(true)
+	data, err := json.Marshal(b)
+	maybePanic(err)
+	assertJSONEquals(t, data, "true", "non-empty json marshal")
+
+	null := NewLenientBool(false, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
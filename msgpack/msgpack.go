@@ -0,0 +1,379 @@
+// Package msgpack encodes and decodes the types of github.com/vitdevelop/null and its zero package
+// in MessagePack format, without reflection or dependencies.
+//
+// For every type there is an Append function, which appends a value to a byte slice,
+// and a Read function, which reads a value from the start of a byte slice and returns the remaining bytes:
+//
+//	b = msgpack.AppendInt64(b, id)
+//	b = msgpack.AppendZeroString(b, name)
+//	...
+//	id, b, err = msgpack.ReadInt64(b)
+//	name, b, err = msgpack.ReadZeroString(b)
+//
+// Null values of the null package are encoded as nil, and valid values as the native MessagePack type:
+// the smallest int or uint family member for integers, float64 for Float, str for String,
+// and the timestamp extension type (-1) for Time and Timestamp.
+// Null values of the zero package are encoded as their zero value.
+//
+// Decoding accepts any member of the int family for integers, and integers as well as float32 and float64 for Float.
+// String accepts str and bin. Times are decoded in UTC, as the timestamp extension has no time zone.
+package msgpack
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+var (
+	// ErrShortBytes is returned when the input ends in the middle of a value.
+	ErrShortBytes = errors.New("msgpack: too few bytes left to read value")
+	// ErrOverflow is returned when an integer doesn't fit the type it is read into.
+	ErrOverflow = errors.New("msgpack: integer overflow")
+	// ErrType is returned, wrapped, when the input holds a value of an unexpected type.
+	ErrType = errors.New("msgpack: unexpected type")
+)
+
+// timestampExt is the extension type of timestamps, -1, as a byte.
+const timestampExt = 0xff
+
+// kind is the type of a MessagePack value, as told by its first byte.
+type kind int
+
+const (
+	kindInvalid kind = iota
+	kindNil
+	kindBool
+	kindInt
+	kindFloat
+	kindStr
+	kindBin
+	kindArray
+	kindMap
+	kindExt
+)
+
+func (k kind) String() string {
+	switch k {
+	case kindNil:
+		return "nil"
+	case kindBool:
+		return "bool"
+	case kindInt:
+		return "int"
+	case kindFloat:
+		return "float"
+	case kindStr:
+		return "str"
+	case kindBin:
+		return "bin"
+	case kindArray:
+		return "array"
+	case kindMap:
+		return "map"
+	case kindExt:
+		return "ext"
+	}
+	return "invalid"
+}
+
+// kindOf returns the kind of the value starting with c.
+func kindOf(c byte) kind {
+	switch {
+	case c <= 0x7f, c >= 0xe0:
+		return kindInt
+	case c <= 0x8f:
+		return kindMap
+	case c <= 0x9f:
+		return kindArray
+	case c <= 0xbf:
+		return kindStr
+	}
+	switch c {
+	case 0xc0:
+		return kindNil
+	case 0xc2, 0xc3:
+		return kindBool
+	case 0xc4, 0xc5, 0xc6:
+		return kindBin
+	case 0xc7, 0xc8, 0xc9, 0xd4, 0xd5, 0xd6, 0xd7, 0xd8:
+		return kindExt
+	case 0xca, 0xcb:
+		return kindFloat
+	case 0xcc, 0xcd, 0xce, 0xcf, 0xd0, 0xd1, 0xd2, 0xd3:
+		return kindInt
+	case 0xd9, 0xda, 0xdb:
+		return kindStr
+	case 0xdc, 0xdd:
+		return kindArray
+	case 0xde, 0xdf:
+		return kindMap
+	}
+	return kindInvalid
+}
+
+// typeError returns an error for a value starting with c where a value of type want was expected.
+func typeError(c byte, want string) error {
+	return fmt.Errorf("%w: got %s, need %s", ErrType, kindOf(c), want)
+}
+
+// need returns ErrShortBytes if b has less than n bytes.
+func need(b []byte, n int) error {
+	if len(b) < n {
+		return ErrShortBytes
+	}
+	return nil
+}
+
+func appendNil(b []byte) []byte {
+	return append(b, 0xc0)
+}
+
+func appendBool(b []byte, v bool) []byte {
+	if v {
+		return append(b, 0xc3)
+	}
+	return append(b, 0xc2)
+}
+
+// appendInt appends v using the smallest representation:
+// non-negative values use the uint family, and negative ones the int family.
+func appendInt(b []byte, v int64) []byte {
+	switch {
+	case v >= 0 && v <= math.MaxInt8:
+		return append(b, byte(v))
+	case v >= 0 && v <= math.MaxUint8:
+		return append(b, 0xcc, byte(v))
+	case v >= 0 && v <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(b, 0xcd), uint16(v))
+	case v >= 0 && v <= math.MaxUint32:
+		return binary.BigEndian.AppendUint32(append(b, 0xce), uint32(v))
+	case v >= 0:
+		return binary.BigEndian.AppendUint64(append(b, 0xcf), uint64(v))
+	case v >= -32:
+		return append(b, byte(v))
+	case v >= math.MinInt8:
+		return append(b, 0xd0, byte(v))
+	case v >= math.MinInt16:
+		return binary.BigEndian.AppendUint16(append(b, 0xd1), uint16(v))
+	case v >= math.MinInt32:
+		return binary.BigEndian.AppendUint32(append(b, 0xd2), uint32(v))
+	}
+	return binary.BigEndian.AppendUint64(append(b, 0xd3), uint64(v))
+}
+
+func appendFloat(b []byte, f float64) []byte {
+	return binary.BigEndian.AppendUint64(append(b, 0xcb), math.Float64bits(f))
+}
+
+func appendStr(b []byte, s string) []byte {
+	switch {
+	case len(s) < 32:
+		b = append(b, 0xa0|byte(len(s)))
+	case len(s) <= math.MaxUint8:
+		b = append(b, 0xd9, byte(len(s)))
+	case len(s) <= math.MaxUint16:
+		b = binary.BigEndian.AppendUint16(append(b, 0xda), uint16(len(s)))
+	default:
+		b = binary.BigEndian.AppendUint32(append(b, 0xdb), uint32(len(s)))
+	}
+	return append(b, s...)
+}
+
+// appendTime appends t as a timestamp extension, using the 32, 64 or 96-bit format.
+func appendTime(b []byte, t time.Time) []byte {
+	sec, nsec := t.Unix(), int64(t.Nanosecond())
+	if sec>>34 == 0 {
+		if nsec == 0 && sec <= math.MaxUint32 {
+			return binary.BigEndian.AppendUint32(append(b, 0xd6, timestampExt), uint32(sec))
+		}
+		return binary.BigEndian.AppendUint64(append(b, 0xd7, timestampExt), uint64(nsec)<<34|uint64(sec))
+	}
+	b = binary.BigEndian.AppendUint32(append(b, 0xc7, 12, timestampExt), uint32(nsec))
+	return binary.BigEndian.AppendUint64(b, uint64(sec))
+}
+
+// readNil returns the bytes after a nil at the start of b, or false if b doesn't start with nil.
+func readNil(b []byte) ([]byte, bool) {
+	if len(b) == 0 || b[0] != 0xc0 {
+		return b, false
+	}
+	return b[1:], true
+}
+
+func readBool(b []byte) (bool, []byte, error) {
+	if err := need(b, 1); err != nil {
+		return false, b, err
+	}
+	switch b[0] {
+	case 0xc2:
+		return false, b[1:], nil
+	case 0xc3:
+		return true, b[1:], nil
+	}
+	return false, b, typeError(b[0], "bool")
+}
+
+// readInt reads an integer of any size, returning ErrOverflow for uint64 values above math.MaxInt64.
+func readInt(b []byte) (int64, []byte, error) {
+	if err := need(b, 1); err != nil {
+		return 0, b, err
+	}
+	c := b[0]
+	switch {
+	case c <= 0x7f:
+		return int64(c), b[1:], nil
+	case c >= 0xe0:
+		return int64(int8(c)), b[1:], nil
+	}
+
+	var size int
+	switch c {
+	case 0xcc, 0xd0:
+		size = 1
+	case 0xcd, 0xd1:
+		size = 2
+	case 0xce, 0xd2:
+		size = 4
+	case 0xcf, 0xd3:
+		size = 8
+	default:
+		return 0, b, typeError(c, "int")
+	}
+	if err := need(b, 1+size); err != nil {
+		return 0, b, err
+	}
+	data, rest := b[1:1+size], b[1+size:]
+
+	switch c {
+	case 0xcc:
+		return int64(data[0]), rest, nil
+	case 0xcd:
+		return int64(binary.BigEndian.Uint16(data)), rest, nil
+	case 0xce:
+		return int64(binary.BigEndian.Uint32(data)), rest, nil
+	case 0xcf:
+		n := binary.BigEndian.Uint64(data)
+		if n > math.MaxInt64 {
+			return 0, b, ErrOverflow
+		}
+		return int64(n), rest, nil
+	case 0xd0:
+		return int64(int8(data[0])), rest, nil
+	case 0xd1:
+		return int64(int16(binary.BigEndian.Uint16(data))), rest, nil
+	case 0xd2:
+		return int64(int32(binary.BigEndian.Uint32(data))), rest, nil
+	}
+	return int64(binary.BigEndian.Uint64(data)), rest, nil
+}
+
+// readFloat reads a float32, float64 or integer.
+func readFloat(b []byte) (float64, []byte, error) {
+	if err := need(b, 1); err != nil {
+		return 0, b, err
+	}
+	switch b[0] {
+	case 0xca:
+		if err := need(b, 5); err != nil {
+			return 0, b, err
+		}
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(b[1:]))), b[5:], nil
+	case 0xcb:
+		if err := need(b, 9); err != nil {
+			return 0, b, err
+		}
+		return math.Float64frombits(binary.BigEndian.Uint64(b[1:])), b[9:], nil
+	}
+	if kindOf(b[0]) != kindInt {
+		return 0, b, typeError(b[0], "float")
+	}
+	// uint64 values too large for int64 are still valid floats
+	if b[0] == 0xcf && len(b) >= 9 {
+		return float64(binary.BigEndian.Uint64(b[1:])), b[9:], nil
+	}
+	n, rest, err := readInt(b)
+	return float64(n), rest, err
+}
+
+// readStr reads a str or bin.
+func readStr(b []byte) (string, []byte, error) {
+	if err := need(b, 1); err != nil {
+		return "", b, err
+	}
+	c := b[0]
+	var n, header int
+	switch c {
+	case 0xd9, 0xc4:
+		if err := need(b, 2); err != nil {
+			return "", b, err
+		}
+		n, header = int(b[1]), 2
+	case 0xda, 0xc5:
+		if err := need(b, 3); err != nil {
+			return "", b, err
+		}
+		n, header = int(binary.BigEndian.Uint16(b[1:])), 3
+	case 0xdb, 0xc6:
+		if err := need(b, 5); err != nil {
+			return "", b, err
+		}
+		n, header = int(binary.BigEndian.Uint32(b[1:])), 5
+	default:
+		if kindOf(c) != kindStr {
+			return "", b, typeError(c, "str or bin")
+		}
+		n, header = int(c&0x1f), 1
+	}
+	if err := need(b[header:], n); err != nil {
+		return "", b, err
+	}
+	return string(b[header : header+n]), b[header+n:], nil
+}
+
+// readTime reads a timestamp extension in any of its formats.
+func readTime(b []byte) (time.Time, []byte, error) {
+	if err := need(b, 1); err != nil {
+		return time.Time{}, b, err
+	}
+	var size, header int
+	switch b[0] {
+	case 0xd6:
+		size, header = 4, 2
+	case 0xd7:
+		size, header = 8, 2
+	case 0xc7:
+		if err := need(b, 2); err != nil {
+			return time.Time{}, b, err
+		}
+		size, header = int(b[1]), 3
+	default:
+		return time.Time{}, b, typeError(b[0], "timestamp ext")
+	}
+	if err := need(b, header+size); err != nil {
+		return time.Time{}, b, err
+	}
+	if b[header-1] != timestampExt {
+		return time.Time{}, b, fmt.Errorf("%w: got ext type %d, need timestamp ext", ErrType, int8(b[header-1]))
+	}
+	data, rest := b[header:header+size], b[header+size:]
+
+	var sec, nsec int64
+	switch size {
+	case 4:
+		sec = int64(binary.BigEndian.Uint32(data))
+	case 8:
+		v := binary.BigEndian.Uint64(data)
+		sec, nsec = int64(v&(1<<34-1)), int64(v>>34)
+	case 12:
+		sec, nsec = int64(binary.BigEndian.Uint64(data[4:])), int64(binary.BigEndian.Uint32(data))
+	default:
+		return time.Time{}, b, fmt.Errorf("msgpack: invalid timestamp ext length %d", size)
+	}
+	if nsec >= int64(time.Second) {
+		return time.Time{}, b, fmt.Errorf("msgpack: invalid timestamp nanoseconds %d", nsec)
+	}
+	return time.Unix(sec, nsec).UTC(), rest, nil
+}
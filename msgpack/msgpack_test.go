@@ -0,0 +1,200 @@
+package msgpack
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAppendInt(t *testing.T) {
+	tests := []struct {
+		v    int64
+		want []byte
+	}{
+		{0, []byte{0x00}},
+		{127, []byte{0x7f}},
+		{128, []byte{0xcc, 0x80}},
+		{255, []byte{0xcc, 0xff}},
+		{256, []byte{0xcd, 0x01, 0x00}},
+		{65536, []byte{0xce, 0x00, 0x01, 0x00, 0x00}},
+		{1 << 32, []byte{0xcf, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00}},
+		{math.MaxInt64, []byte{0xcf, 0x7f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+		{-1, []byte{0xff}},
+		{-32, []byte{0xe0}},
+		{-33, []byte{0xd0, 0xdf}},
+		{-129, []byte{0xd1, 0xff, 0x7f}},
+		{-32769, []byte{0xd2, 0xff, 0xff, 0x7f, 0xff}},
+		{math.MinInt64, []byte{0xd3, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}},
+	}
+	for _, test := range tests {
+		b := appendInt(nil, test.v)
+		if !bytes.Equal(b, test.want) {
+			t.Errorf("appendInt(%d): got % x, want % x", test.v, b, test.want)
+		}
+		n, rest, err := readInt(append(b, 0xc0))
+		if err != nil || n != test.v || !bytes.Equal(rest, []byte{0xc0}) {
+			t.Errorf("readInt(% x): got %d, % x, %v", b, n, rest, err)
+		}
+	}
+}
+
+func TestReadInt(t *testing.T) {
+	// signed encodings of small values, which other encoders may produce
+	for _, b := range [][]byte{
+		{0xd0, 0x05},
+		{0xd1, 0x00, 0x05},
+		{0xd2, 0x00, 0x00, 0x00, 0x05},
+		{0xd3, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05},
+	} {
+		n, _, err := readInt(b)
+		if err != nil || n != 5 {
+			t.Errorf("readInt(% x): got %d, %v", b, n, err)
+		}
+	}
+
+	_, _, err := readInt([]byte{0xcf, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00})
+	if !errors.Is(err, ErrOverflow) {
+		t.Errorf("readInt(uint64 above MaxInt64): got %v, want ErrOverflow", err)
+	}
+}
+
+func TestFloat(t *testing.T) {
+	b := appendFloat(nil, 1.5)
+	if want := []byte{0xcb, 0x3f, 0xf8, 0, 0, 0, 0, 0, 0}; !bytes.Equal(b, want) {
+		t.Errorf("appendFloat(1.5): got % x, want % x", b, want)
+	}
+
+	tests := []struct {
+		b    []byte
+		want float64
+	}{
+		{b, 1.5},
+		{[]byte{0xca, 0x3f, 0xc0, 0x00, 0x00}, 1.5},
+		{[]byte{0x05}, 5},
+		{[]byte{0xd0, 0xfb}, -5},
+		{[]byte{0xcf, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, math.MaxUint64},
+	}
+	for _, test := range tests {
+		f, rest, err := readFloat(test.b)
+		if err != nil || f != test.want || len(rest) != 0 {
+			t.Errorf("readFloat(% x): got %v, % x, %v", test.b, f, rest, err)
+		}
+	}
+}
+
+func TestStr(t *testing.T) {
+	tests := []struct {
+		n      int
+		header []byte
+	}{
+		{0, []byte{0xa0}},
+		{31, []byte{0xbf}},
+		{32, []byte{0xd9, 32}},
+		{255, []byte{0xd9, 0xff}},
+		{256, []byte{0xda, 0x01, 0x00}},
+		{65536, []byte{0xdb, 0x00, 0x01, 0x00, 0x00}},
+	}
+	for _, test := range tests {
+		s := strings.Repeat("a", test.n)
+		b := appendStr(nil, s)
+		if !bytes.HasPrefix(b, test.header) || len(b) != len(test.header)+test.n {
+			t.Errorf("appendStr(%d bytes): got header % x, want % x", test.n, b[:len(test.header)], test.header)
+		}
+		got, rest, err := readStr(b)
+		if err != nil || got != s || len(rest) != 0 {
+			t.Errorf("readStr(%d bytes): got %d bytes, % x, %v", test.n, len(got), rest, err)
+		}
+	}
+
+	for _, b := range [][]byte{{0xc4, 2, 'h', 'i'}, {0xc5, 0, 2, 'h', 'i'}, {0xc6, 0, 0, 0, 2, 'h', 'i'}} {
+		got, _, err := readStr(b)
+		if err != nil || got != "hi" {
+			t.Errorf("readStr(% x): got %q, %v", b, got, err)
+		}
+	}
+}
+
+func TestTime(t *testing.T) {
+	tests := []struct {
+		t    time.Time
+		want []byte
+	}{
+		{time.Unix(1, 0), []byte{0xd6, 0xff, 0x00, 0x00, 0x00, 0x01}},
+		{time.Unix(1, 1), []byte{0xd7, 0xff, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x01}},
+		{time.Unix(1<<32, 0), []byte{0xd7, 0xff, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00}},
+		{time.Unix(-1, 0), []byte{0xc7, 12, 0xff, 0, 0, 0, 0, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+		{time.Unix(1<<34, 5), []byte{0xc7, 12, 0xff, 0, 0, 0, 5, 0, 0, 0, 0x04, 0, 0, 0, 0}},
+	}
+	for _, test := range tests {
+		b := appendTime(nil, test.t)
+		if !bytes.Equal(b, test.want) {
+			t.Errorf("appendTime(%v): got % x, want % x", test.t, b, test.want)
+		}
+		got, rest, err := readTime(b)
+		if err != nil || !got.Equal(test.t) || got.Location() != time.UTC || len(rest) != 0 {
+			t.Errorf("readTime(% x): got %v, % x, %v", b, got, rest, err)
+		}
+	}
+
+	// zero time.Time is far before the epoch
+	zero, _, err := readTime(appendTime(nil, time.Time{}))
+	if err != nil || !zero.IsZero() {
+		t.Errorf("zero time: got %v, %v", zero, err)
+	}
+}
+
+func TestReadErrors(t *testing.T) {
+	short := []struct {
+		name string
+		read func([]byte) error
+		b    []byte
+	}{
+		{"int", readErr(readInt), []byte{0xcd, 0x01}},
+		{"float", readErr(readFloat), []byte{0xcb, 0x01}},
+		{"bool", readErr(readBool), nil},
+		{"str", readErr(readStr), []byte{0xa3, 'h', 'i'}},
+		{"str8", readErr(readStr), []byte{0xd9}},
+		{"time", readErr(readTime), []byte{0xd6, 0xff, 0x00}},
+	}
+	for _, test := range short {
+		if err := test.read(test.b); !errors.Is(err, ErrShortBytes) {
+			t.Errorf("%s(% x): got %v, want ErrShortBytes", test.name, test.b, err)
+		}
+	}
+
+	wrongType := []struct {
+		name string
+		read func([]byte) error
+		b    []byte
+	}{
+		{"int", readErr(readInt), []byte{0xc3}},
+		{"float", readErr(readFloat), []byte{0xa0}},
+		{"bool", readErr(readBool), []byte{0x01}},
+		{"str", readErr(readStr), []byte{0x90}},
+		{"time", readErr(readTime), []byte{0x01}},
+		{"time ext type", readErr(readTime), []byte{0xd6, 0x01, 0x00, 0x00, 0x00, 0x01}},
+	}
+	for _, test := range wrongType {
+		if err := test.read(test.b); !errors.Is(err, ErrType) {
+			t.Errorf("%s(% x): got %v, want ErrType", test.name, test.b, err)
+		}
+	}
+
+	// nanoseconds must be less than a second
+	if _, _, err := readTime([]byte{0xc7, 12, 0xff, 0x3b, 0x9a, 0xca, 0x00, 0, 0, 0, 0, 0, 0, 0, 0}); err == nil {
+		t.Error("readTime: expected error for 1e9 nanoseconds")
+	}
+}
+
+func readErr[T any](read func([]byte) (T, []byte, error)) func([]byte) error {
+	return func(b []byte) error {
+		_, rest, err := read(b)
+		if err != nil && !bytes.Equal(rest, b) {
+			return errors.New("input not returned on error")
+		}
+		return err
+	}
+}
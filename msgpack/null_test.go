@@ -0,0 +1,140 @@
+package msgpack
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/vitdevelop/null"
+)
+
+func TestNullRoundTrip(t *testing.T) {
+	created := time.Date(2012, 12, 21, 21, 21, 21, 123456789, time.UTC)
+	for _, valid := range []bool{true, false} {
+		var b []byte
+		b = AppendInt64(b, null.NewInt64(math.MinInt64, valid))
+		b = AppendInt32(b, null.NewInt32(math.MaxInt32, valid))
+		b = AppendFloat(b, null.NewFloat(1.2345, valid))
+		b = AppendBool(b, null.NewBool(false, valid))
+		b = AppendLenientBool(b, null.NewLenientBool(true, valid))
+		b = AppendString(b, null.NewString("", valid))
+		b = AppendTime(b, null.NewTime(created, valid))
+		b = AppendTimestamp(b, null.NewTimestamp(created, valid))
+
+		i, b, err := ReadInt64(b)
+		maybePanic(err)
+		assertValid(t, i.Valid, valid, i.Int64 == math.MinInt64, "Int64")
+		i32, b, err := ReadInt32(b)
+		maybePanic(err)
+		assertValid(t, i32.Valid, valid, i32.Int32 == math.MaxInt32, "Int32")
+		f, b, err := ReadFloat(b)
+		maybePanic(err)
+		assertValid(t, f.Valid, valid, f.Float64 == 1.2345, "Float")
+		bo, b, err := ReadBool(b)
+		maybePanic(err)
+		assertValid(t, bo.Valid, valid, !bo.Bool, "Bool")
+		lb, b, err := ReadLenientBool(b)
+		maybePanic(err)
+		assertValid(t, lb.Valid, valid, lb.Bool.Bool, "LenientBool")
+		s, b, err := ReadString(b)
+		maybePanic(err)
+		assertValid(t, s.Valid, valid, s.String == "", "String")
+		ti, b, err := ReadTime(b)
+		maybePanic(err)
+		assertValid(t, ti.Valid, valid, ti.Time.Equal(created), "Time")
+		ts, b, err := ReadTimestamp(b)
+		maybePanic(err)
+		assertValid(t, ts.Valid, valid, ts.Time.Equal(created), "Timestamp")
+
+		if len(b) != 0 {
+			t.Errorf("%d bytes left over", len(b))
+		}
+	}
+}
+
+func TestAppendNull(t *testing.T) {
+	tests := []struct {
+		b    []byte
+		want []byte
+	}{
+		{AppendInt64(nil, null.Int64From(1)), []byte{0x01}},
+		{AppendInt64(nil, null.Int64{}), []byte{0xc0}},
+		{AppendInt32(nil, null.Int32From(-1)), []byte{0xff}},
+		{AppendBool(nil, null.BoolFrom(true)), []byte{0xc3}},
+		{AppendString(nil, null.StringFrom("hi")), []byte{0xa2, 'h', 'i'}},
+		{AppendTime(nil, null.TimeFrom(time.Unix(1, 0))), []byte{0xd6, 0xff, 0, 0, 0, 1}},
+	}
+	for _, test := range tests {
+		if !bytes.Equal(test.b, test.want) {
+			t.Errorf("got % x, want % x", test.b, test.want)
+		}
+	}
+}
+
+func TestReadInt32Overflow(t *testing.T) {
+	b := AppendInt64(nil, null.Int64From(math.MaxInt32+1))
+	if _, rest, err := ReadInt32(b); !errors.Is(err, ErrOverflow) || !bytes.Equal(rest, b) {
+		t.Errorf("ReadInt32(MaxInt32+1): got %v, want ErrOverflow", err)
+	}
+	b = AppendInt64(nil, null.Int64From(math.MinInt32-1))
+	if _, _, err := ReadInt32(b); !errors.Is(err, ErrOverflow) {
+		t.Errorf("ReadInt32(MinInt32-1): got %v, want ErrOverflow", err)
+	}
+}
+
+func TestReadLenientBool(t *testing.T) {
+	tests := []struct {
+		b     []byte
+		want  bool
+		valid bool
+	}{
+		{[]byte{0xc3}, true, true},
+		{[]byte{0xc2}, false, true},
+		{[]byte{0x01}, true, true},
+		{[]byte{0xd0, 0x00}, false, true},
+		{appendStr(nil, "yes"), true, true},
+		{appendStr(nil, "off"), false, true},
+		{appendStr(nil, ""), false, false},
+		{[]byte{0xc0}, false, false},
+	}
+	for _, test := range tests {
+		v, rest, err := ReadLenientBool(test.b)
+		if err != nil || v.Valid != test.valid || (v.Valid && v.Bool.Bool != test.want) || len(rest) != 0 {
+			t.Errorf("ReadLenientBool(% x): got %v, % x, %v", test.b, v, rest, err)
+		}
+	}
+
+	for _, b := range [][]byte{{0x02}, appendStr(nil, "maybe"), {0xcb, 0, 0, 0, 0, 0, 0, 0, 0}, nil} {
+		if _, _, err := ReadLenientBool(b); err == nil {
+			t.Errorf("ReadLenientBool(% x): expected error", b)
+		}
+	}
+}
+
+func TestReadNullWrongType(t *testing.T) {
+	b := AppendString(nil, null.StringFrom("12"))
+	if _, rest, err := ReadInt64(b); !errors.Is(err, ErrType) || !bytes.Equal(rest, b) {
+		t.Errorf("ReadInt64(str): got %v, want ErrType", err)
+	}
+	if _, _, err := ReadTime(AppendInt64(nil, null.Int64From(1))); !errors.Is(err, ErrType) {
+		t.Errorf("ReadTime(int): got %v, want ErrType", err)
+	}
+}
+
+func assertValid(t *testing.T, valid, wantValid, valueOK bool, from string) {
+	t.Helper()
+	if valid != wantValid {
+		t.Errorf("%s: got valid %v, want %v", from, valid, wantValid)
+	}
+	if valid && !valueOK {
+		t.Errorf("%s: bad value", from)
+	}
+}
+
+func maybePanic(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,19 @@
+// Command nullvet runs the nullcheck analyzer as a vet tool.
+//
+// Usage:
+//
+//	go install github.com/vitdevelop/null/nullcheck/cmd/nullvet@latest
+//	go vet -vettool=$(which nullvet) ./...
+//
+// See package github.com/vitdevelop/null/nullcheck for the reported issues.
+package main
+
+import (
+	"github.com/vitdevelop/null/nullcheck"
+
+	"golang.org/x/tools/go/analysis/unitchecker"
+)
+
+func main() {
+	unitchecker.Main(nullcheck.Analyzer)
+}
@@ -0,0 +1,300 @@
+// Package nullcheck defines an analyzer that reports unsafe uses of the nullable types
+// in github.com/vitdevelop/null and its zero package.
+//
+// It reports:
+//   - reads of a value field, such as x.Int64 or x.String, that aren't guarded by a check of x.Valid
+//   - comparisons of Time and Timestamp values with == or !=, which compare locations and monotonic clock readings
+//   - struct types with fields from both the null and zero packages, whose JSON and SQL behavior differs
+//
+// A read is guarded if it is inside the body of an if statement, or the right-hand side of &&,
+// whose condition implies x.Valid, or if it follows an if statement that leaves the block when x is invalid:
+//
+//	if !x.Valid {
+//		return 0
+//	}
+//	return x.Int64
+//
+// Assignments between the check and the read aren't tracked.
+//
+// The analyzer is in its own module, github.com/vitdevelop/null/nullcheck, and can be run
+// with go vet through the nullvet command:
+//
+//	go install github.com/vitdevelop/null/nullcheck/cmd/nullvet@latest
+//	go vet -vettool=$(which nullvet) ./...
+package nullcheck
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+// modulePath is the import path of the null package.
+const modulePath = "github.com/vitdevelop/null"
+
+// Analyzer reports unsafe uses of null and zero types.
+var Analyzer = &analysis.Analyzer{
+	Name:     "nullcheck",
+	Doc:      "report unguarded value reads, == comparisons of times, and mixed null and zero fields",
+	URL:      "https://pkg.go.dev/github.com/vitdevelop/null/nullcheck",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	// the packages defining the types access their fields freely
+	if packageKind(pass.Pkg.Path()) != "" {
+		return nil, nil
+	}
+
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	filter := []ast.Node{(*ast.SelectorExpr)(nil), (*ast.BinaryExpr)(nil), (*ast.StructType)(nil)}
+	insp.WithStack(filter, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+		switch n := n.(type) {
+		case *ast.SelectorExpr:
+			checkRead(pass, n, stack)
+		case *ast.BinaryExpr:
+			checkTimeComparison(pass, n)
+		case *ast.StructType:
+			checkMixedStruct(pass, n)
+		}
+		return true
+	})
+	return nil, nil
+}
+
+// packageKind returns "null" or "zero" for the packages of this module that define nullable types,
+// and "" for other packages.
+func packageKind(path string) string {
+	switch path {
+	case modulePath, modulePath + "/compat":
+		return "null"
+	case modulePath + "/zero", modulePath + "/compat/zero":
+		return "zero"
+	}
+	return ""
+}
+
+// nullType returns the named type if t is, or points to, a type defined in this module's packages.
+func nullType(t types.Type) *types.Named {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok || named.Obj().Pkg() == nil || packageKind(named.Obj().Pkg().Path()) == "" {
+		return nil
+	}
+	return named
+}
+
+// checkRead reports sel if it reads the value field of a nullable type without a guarding Valid check.
+func checkRead(pass *analysis.Pass, sel *ast.SelectorExpr, stack []ast.Node) {
+	selection, ok := pass.TypesInfo.Selections[sel]
+	if !ok || selection.Kind() != types.FieldVal {
+		return
+	}
+	field := selection.Obj()
+	if field.Pkg() == nil || field.Pkg().Path() != "database/sql" || field.Name() == "Valid" {
+		return
+	}
+	named := nullType(selection.Recv())
+	if named == nil {
+		return
+	}
+
+	switch parent := stack[len(stack)-2].(type) {
+	case *ast.AssignStmt:
+		// plain assignments don't read the field
+		if parent.Tok == token.ASSIGN || parent.Tok == token.DEFINE {
+			for _, lhs := range parent.Lhs {
+				if lhs == sel {
+					return
+				}
+			}
+		}
+	case *ast.UnaryExpr:
+		// taking the address, as when scanning into the field, doesn't read it
+		if parent.Op == token.AND {
+			return
+		}
+	}
+
+	key := types.ExprString(sel.X)
+	if guarded(key, stack) {
+		return
+	}
+	pass.ReportRangef(sel, "%s.%s read without checking %s.Valid; check Valid first or use ValueOrZero",
+		key, sel.Sel.Name, key)
+}
+
+// guarded reports whether the innermost node of stack only runs when the value named key is valid.
+func guarded(key string, stack []ast.Node) bool {
+	for i := len(stack) - 2; i >= 0; i-- {
+		child := stack[i+1]
+		switch parent := stack[i].(type) {
+		case *ast.FuncDecl, *ast.FuncLit:
+			return false
+		case *ast.IfStmt:
+			if child == parent.Body && whenTrue(key, parent.Cond) {
+				return true
+			}
+			if child == parent.Else && whenFalse(key, parent.Cond) {
+				return true
+			}
+		case *ast.BinaryExpr:
+			if child != parent.Y {
+				continue
+			}
+			if parent.Op == token.LAND && whenTrue(key, parent.X) {
+				return true
+			}
+			if parent.Op == token.LOR && whenFalse(key, parent.X) {
+				return true
+			}
+		case *ast.BlockStmt:
+			if leftWhenInvalid(key, parent.List, child) {
+				return true
+			}
+		case *ast.CaseClause:
+			if leftWhenInvalid(key, parent.Body, child) {
+				return true
+			}
+			// a tagless switch case is a chain of ifs
+			if i >= 2 && len(parent.List) == 1 && parent.List[0] != child {
+				if sw, ok := stack[i-2].(*ast.SwitchStmt); ok && sw.Tag == nil && whenTrue(key, parent.List[0]) {
+					return true
+				}
+			}
+		case *ast.CommClause:
+			if leftWhenInvalid(key, parent.Body, child) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// leftWhenInvalid reports whether a statement before child in list leaves the block
+// unless the value named key is valid.
+func leftWhenInvalid(key string, list []ast.Stmt, child ast.Node) bool {
+	for _, stmt := range list {
+		if stmt == child {
+			return false
+		}
+		ifStmt, ok := stmt.(*ast.IfStmt)
+		if ok && ifStmt.Else == nil && terminates(ifStmt.Body) && whenFalse(key, ifStmt.Cond) {
+			return true
+		}
+	}
+	return false
+}
+
+// terminates reports whether block always leaves the enclosing block.
+func terminates(block *ast.BlockStmt) bool {
+	if len(block.List) == 0 {
+		return false
+	}
+	switch last := block.List[len(block.List)-1].(type) {
+	case *ast.ReturnStmt, *ast.BranchStmt:
+		return true
+	case *ast.ExprStmt:
+		call, ok := last.X.(*ast.CallExpr)
+		if !ok {
+			return false
+		}
+		id, ok := call.Fun.(*ast.Ident)
+		return ok && id.Name == "panic"
+	}
+	return false
+}
+
+// whenTrue reports whether cond being true implies that the value named key is valid.
+func whenTrue(key string, cond ast.Expr) bool {
+	switch cond := ast.Unparen(cond).(type) {
+	case *ast.SelectorExpr:
+		return cond.Sel.Name == "Valid" && types.ExprString(cond.X) == key
+	case *ast.UnaryExpr:
+		return cond.Op == token.NOT && whenFalse(key, cond.X)
+	case *ast.BinaryExpr:
+		return cond.Op == token.LAND && (whenTrue(key, cond.X) || whenTrue(key, cond.Y))
+	}
+	return false
+}
+
+// whenFalse reports whether cond being false implies that the value named key is valid.
+func whenFalse(key string, cond ast.Expr) bool {
+	switch cond := ast.Unparen(cond).(type) {
+	case *ast.CallExpr:
+		// IsZero is true for invalid values in both packages
+		sel, ok := cond.Fun.(*ast.SelectorExpr)
+		return ok && len(cond.Args) == 0 && sel.Sel.Name == "IsZero" && types.ExprString(sel.X) == key
+	case *ast.UnaryExpr:
+		return cond.Op == token.NOT && whenTrue(key, cond.X)
+	case *ast.BinaryExpr:
+		return cond.Op == token.LOR && (whenFalse(key, cond.X) || whenFalse(key, cond.Y))
+	}
+	return false
+}
+
+// checkTimeComparison reports == and != comparisons of Time and Timestamp values.
+func checkTimeComparison(pass *analysis.Pass, expr *ast.BinaryExpr) {
+	if expr.Op != token.EQL && expr.Op != token.NEQ {
+		return
+	}
+	for _, operand := range []ast.Expr{expr.X, expr.Y} {
+		t := pass.TypesInfo.TypeOf(operand)
+		if _, ok := t.(*types.Pointer); ok {
+			continue
+		}
+		named := nullType(t)
+		if named == nil {
+			continue
+		}
+		if name := named.Obj().Name(); name == "Time" || name == "Timestamp" {
+			pass.ReportRangef(expr, "%s.%s values compared with %s; use Equal",
+				named.Obj().Pkg().Name(), name, expr.Op)
+			return
+		}
+	}
+}
+
+// checkMixedStruct reports struct types with fields from both the null and zero packages.
+func checkMixedStruct(pass *analysis.Pass, st *ast.StructType) {
+	first := make(map[string]string)
+	for _, field := range st.Fields.List {
+		named := nullType(pass.TypesInfo.TypeOf(field.Type))
+		if named == nil {
+			continue
+		}
+		kind := packageKind(named.Obj().Pkg().Path())
+		if _, ok := first[kind]; ok {
+			continue
+		}
+		first[kind] = fieldName(field, pass.TypesInfo.TypeOf(field.Type))
+	}
+	if len(first) == 2 {
+		pass.ReportRangef(st, "struct mixes null and zero types (%s and %s); their JSON and SQL handling differs",
+			first["null"], first["zero"])
+	}
+}
+
+// fieldName describes field, of type t, for diagnostics.
+func fieldName(field *ast.Field, t types.Type) string {
+	typ := types.TypeString(t, func(pkg *types.Package) string { return pkg.Name() })
+	if len(field.Names) == 0 {
+		return "embedded " + typ
+	}
+	names := make([]string, len(field.Names))
+	for i, name := range field.Names {
+		names[i] = name.Name
+	}
+	return strings.Join(names, ", ") + " " + typ
+}
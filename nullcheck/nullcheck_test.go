@@ -0,0 +1,13 @@
+package nullcheck_test
+
+import (
+	"testing"
+
+	"github.com/vitdevelop/null/nullcheck"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), nullcheck.Analyzer, "a")
+}
@@ -0,0 +1,112 @@
+package a
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/vitdevelop/null"
+	"github.com/vitdevelop/null/zero"
+)
+
+func unguarded(x null.Int64, s *null.String) {
+	fmt.Println(x.Int64)     // want `x.Int64 read without checking x.Valid`
+	fmt.Println(s.String)    // want `s.String read without checking s.Valid`
+	fmt.Println(x.Int64 + 1) // want `x.Int64 read without checking x.Valid`
+	x.Int64++                // want `x.Int64 read without checking x.Valid`
+}
+
+func guardedIf(x, y null.Int64) int64 {
+	if x.Valid {
+		return x.Int64
+	}
+	if x.Valid && y.Valid {
+		return x.Int64 + y.Int64
+	}
+	if !x.Valid {
+		return 0
+	} else {
+		return x.Int64
+	}
+}
+
+func guardedEarlyReturn(x, y null.Int64) int64 {
+	if !x.Valid || y.IsZero() {
+		return 0
+	}
+	return x.Int64 + y.Int64
+}
+
+func guardedLoop(xs []null.Int64) (sum int64) {
+	for _, x := range xs {
+		if !x.Valid {
+			continue
+		}
+		sum += x.Int64
+	}
+	return sum
+}
+
+func guardedExpr(x null.Int64, t null.Time) bool {
+	return x.Valid && x.Int64 > 0 || !t.Valid || t.Time.IsZero()
+}
+
+func guardedSwitch(x null.Int64) int64 {
+	switch {
+	case x.Valid:
+		return x.Int64
+	}
+	return 0
+}
+
+func wrongGuard(x, y null.Int64) int64 {
+	if y.Valid {
+		return x.Int64 // want `x.Int64 read without checking x.Valid`
+	}
+	if x.Valid || y.Valid {
+		return x.Int64 // want `x.Int64 read without checking x.Valid`
+	}
+	return 0
+}
+
+func closure(x null.Int64) func() int64 {
+	if x.Valid {
+		return func() int64 {
+			return x.Int64 // want `x.Int64 read without checking x.Valid`
+		}
+	}
+	return nil
+}
+
+func writes(x *null.Int64, rows *sql.Rows) error {
+	x.Int64 = 1
+	x.Valid = true
+	return rows.Scan(&x.Int64)
+}
+
+func zeroReads(z zero.Int64) int64 {
+	return z.Int64 // want `z.Int64 read without checking z.Valid`
+}
+
+func times(a, b null.Time, c, d null.Timestamp, e, f zero.Time) bool {
+	_ = a == b // want `null.Time values compared with ==; use Equal`
+	_ = c != d // want `null.Timestamp values compared with !=; use Equal`
+	_ = e == f // want `zero.Time values compared with ==; use Equal`
+	_ = &a == &b
+	return a.Equal(b)
+}
+
+type mixed struct { // want `struct mixes null and zero types \(ID null.Int64 and Count zero.Int64\)`
+	ID    null.Int64
+	Name  null.String
+	Count zero.Int64
+}
+
+type mixedEmbedded struct { // want `struct mixes null and zero types \(Created \*null.Time and embedded zero.Int64\)`
+	Created *null.Time
+	zero.Int64
+}
+
+type onlyNull struct {
+	ID   null.Int64
+	Name null.String
+}
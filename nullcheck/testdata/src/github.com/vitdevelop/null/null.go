@@ -0,0 +1,15 @@
+package null
+
+import "database/sql"
+
+type Int64 struct{ sql.NullInt64 }
+
+func (i Int64) IsZero() bool { return !i.Valid }
+
+type String struct{ sql.NullString }
+
+type Time struct{ sql.NullTime }
+
+func (t Time) Equal(other Time) bool { return t.Valid == other.Valid && t.Time.Equal(other.Time) }
+
+type Timestamp struct{ sql.NullTime }
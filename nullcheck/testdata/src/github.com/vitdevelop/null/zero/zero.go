@@ -0,0 +1,7 @@
+package zero
+
+import "database/sql"
+
+type Int64 struct{ sql.NullInt64 }
+
+type Time struct{ sql.NullTime }
@@ -0,0 +1,247 @@
+package protobuf
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/vitdevelop/null"
+)
+
+// MarshalInt64JSON returns the protojson representation of v: a decimal string, or null if v is null.
+func MarshalInt64JSON(v null.Int64) ([]byte, error) {
+	if !v.Valid {
+		return []byte("null"), nil
+	}
+	b := append([]byte{'"'}, strconv.FormatInt(v.Int64, 10)...)
+	return append(b, '"'), nil
+}
+
+// UnmarshalInt64JSON parses the protojson representation of a google.protobuf.Int64Value.
+// It accepts a number, a string holding a number, or null.
+func UnmarshalInt64JSON(data []byte) (null.Int64, error) {
+	s, isNull, err := jsonNumber(data, "Int64Value")
+	if err != nil || isNull {
+		return null.NewInt64(0, false), err
+	}
+	n, err := parseInt(s, 64)
+	if err != nil {
+		return null.NewInt64(0, false), err
+	}
+	return null.Int64From(n), nil
+}
+
+// MarshalInt32JSON returns the protojson representation of v: a number, or null if v is null.
+func MarshalInt32JSON(v null.Int32) ([]byte, error) {
+	if !v.Valid {
+		return []byte("null"), nil
+	}
+	return strconv.AppendInt(nil, int64(v.Int32), 10), nil
+}
+
+// UnmarshalInt32JSON parses the protojson representation of a google.protobuf.Int32Value.
+// It accepts a number, a string holding a number, or null.
+func UnmarshalInt32JSON(data []byte) (null.Int32, error) {
+	s, isNull, err := jsonNumber(data, "Int32Value")
+	if err != nil || isNull {
+		return null.NewInt32(0, false), err
+	}
+	n, err := parseInt(s, 32)
+	if err != nil {
+		return null.NewInt32(0, false), err
+	}
+	return null.Int32From(int32(n)), nil
+}
+
+// MarshalFloatJSON returns the protojson representation of v: a number,
+// the strings "NaN", "Infinity" or "-Infinity", or null if v is null.
+func MarshalFloatJSON(v null.Float) ([]byte, error) {
+	switch {
+	case !v.Valid:
+		return []byte("null"), nil
+	case math.IsNaN(v.Float64):
+		return []byte(`"NaN"`), nil
+	case math.IsInf(v.Float64, 1):
+		return []byte(`"Infinity"`), nil
+	case math.IsInf(v.Float64, -1):
+		return []byte(`"-Infinity"`), nil
+	}
+	return json.Marshal(v.Float64)
+}
+
+// UnmarshalFloatJSON parses the protojson representation of a google.protobuf.DoubleValue.
+// It accepts a number, a string holding a number, "NaN", "Infinity" or "-Infinity", or null.
+func UnmarshalFloatJSON(data []byte) (null.Float, error) {
+	switch string(bytes.TrimSpace(data)) {
+	case `"NaN"`:
+		return null.FloatFrom(math.NaN()), nil
+	case `"Infinity"`:
+		return null.FloatFrom(math.Inf(1)), nil
+	case `"-Infinity"`:
+		return null.FloatFrom(math.Inf(-1)), nil
+	}
+	s, isNull, err := jsonNumber(data, "DoubleValue")
+	if err != nil || isNull {
+		return null.NewFloat(0, false), err
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return null.NewFloat(0, false), fmt.Errorf("protobuf: couldn't unmarshal JSON: %w", err)
+	}
+	return null.FloatFrom(f), nil
+}
+
+// MarshalBoolJSON returns the protojson representation of v: true, false, or null if v is null.
+func MarshalBoolJSON(v null.Bool) ([]byte, error) {
+	if !v.Valid {
+		return []byte("null"), nil
+	}
+	return strconv.AppendBool(nil, v.Bool), nil
+}
+
+// UnmarshalBoolJSON parses the protojson representation of a google.protobuf.BoolValue.
+// It accepts true, false or null.
+func UnmarshalBoolJSON(data []byte) (null.Bool, error) {
+	switch s := string(bytes.TrimSpace(data)); s {
+	case "null":
+		return null.NewBool(false, false), nil
+	case "true", "false":
+		return null.BoolFrom(s == "true"), nil
+	}
+	return null.NewBool(false, false), jsonTypeError(data, "BoolValue")
+}
+
+// MarshalStringJSON returns the protojson representation of v: a string, or null if v is null.
+// Unlike encoding/json, it doesn't escape HTML characters, and returns an error for invalid UTF-8.
+func MarshalStringJSON(v null.String) ([]byte, error) {
+	if !v.Valid {
+		return []byte("null"), nil
+	}
+	if !utf8.ValidString(v.String) {
+		return nil, errors.New("protobuf: invalid UTF-8 in string")
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v.String); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// UnmarshalStringJSON parses the protojson representation of a google.protobuf.StringValue.
+// It accepts a string or null.
+func UnmarshalStringJSON(data []byte) (null.String, error) {
+	data = bytes.TrimSpace(data)
+	if string(data) == "null" {
+		return null.NewString("", false), nil
+	}
+	if len(data) == 0 || data[0] != '"' {
+		return null.NewString("", false), jsonTypeError(data, "StringValue")
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return null.NewString("", false), fmt.Errorf("protobuf: couldn't unmarshal JSON: %w", err)
+	}
+	return null.StringFrom(s), nil
+}
+
+// MarshalTimeJSON returns the protojson representation of v: an RFC 3339 string in UTC
+// with 0, 3, 6 or 9 fractional digits, or null if v is null.
+// It returns an error if the time is outside the range of google.protobuf.Timestamp.
+func MarshalTimeJSON(v null.Time) ([]byte, error) {
+	if !v.Valid {
+		return []byte("null"), nil
+	}
+	t := v.Time.UTC()
+	if err := checkTime(t.Unix(), int64(t.Nanosecond())); err != nil {
+		return nil, err
+	}
+	layout := `"2006-01-02T15:04:05`
+	switch nsec := t.Nanosecond(); {
+	case nsec == 0:
+	case nsec%1e6 == 0:
+		layout += ".000"
+	case nsec%1e3 == 0:
+		layout += ".000000"
+	default:
+		layout += ".000000000"
+	}
+	return t.AppendFormat(nil, layout+`Z"`), nil
+}
+
+// UnmarshalTimeJSON parses the protojson representation of a google.protobuf.Timestamp.
+// It accepts an RFC 3339 string, with any time zone offset, or null. The time is returned in UTC.
+func UnmarshalTimeJSON(data []byte) (null.Time, error) {
+	str, err := UnmarshalStringJSON(data)
+	if err != nil {
+		return null.NewTime(time.Time{}, false), jsonTypeError(data, "Timestamp")
+	}
+	if !str.Valid {
+		return null.NewTime(time.Time{}, false), nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, str.String)
+	if err != nil {
+		return null.NewTime(time.Time{}, false), fmt.Errorf("protobuf: couldn't unmarshal JSON: %w", err)
+	}
+	if err := checkTime(t.Unix(), int64(t.Nanosecond())); err != nil {
+		return null.NewTime(time.Time{}, false), err
+	}
+	return null.TimeFrom(t.UTC()), nil
+}
+
+// jsonNumber returns the text of data, which must be a JSON number, a JSON string holding a number, or null.
+// name is the message type, for errors.
+func jsonNumber(data []byte, name string) (s string, isNull bool, err error) {
+	data = bytes.TrimSpace(data)
+	if string(data) == "null" {
+		return "", true, nil
+	}
+	s = string(data)
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return "", false, fmt.Errorf("protobuf: couldn't unmarshal JSON: %w", err)
+		}
+	}
+	if !isNumber(s) {
+		return "", false, jsonTypeError(data, name)
+	}
+	return s, false, nil
+}
+
+// isNumber reports whether s is a JSON number.
+func isNumber(s string) bool {
+	if s == "" || (s[0] != '-' && (s[0] < '0' || s[0] > '9')) {
+		return false
+	}
+	var n json.Number
+	return json.Unmarshal([]byte(s), &n) == nil
+}
+
+// parseInt parses the JSON number s as an integer of the given bit size.
+// Like protojson, it accepts numbers with an exponent or fraction if their value is an integer.
+func parseInt(s string, bitSize int) (int64, error) {
+	n, err := strconv.ParseInt(s, 10, bitSize)
+	if err == nil {
+		return n, nil
+	}
+	limit := math.Ldexp(1, bitSize-1)
+	f, ferr := strconv.ParseFloat(s, 64)
+	if ferr != nil || f != math.Trunc(f) || f < -limit || f >= limit {
+		return 0, fmt.Errorf("protobuf: couldn't unmarshal JSON: %w", err)
+	}
+	return int64(f), nil
+}
+
+// jsonTypeError returns an error for data that is not a valid representation of the message type name.
+func jsonTypeError(data []byte, name string) error {
+	if len(data) > 32 {
+		data = append(data[:32:32], "..."...)
+	}
+	return fmt.Errorf("protobuf: couldn't unmarshal JSON %s into google.protobuf.%s", data, name)
+}
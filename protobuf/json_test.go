@@ -0,0 +1,198 @@
+package protobuf
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/vitdevelop/null"
+)
+
+func TestInt64JSON(t *testing.T) {
+	marshals := []struct {
+		v    null.Int64
+		want string
+	}{
+		{null.Int64From(math.MinInt64), `"-9223372036854775808"`},
+		{null.Int64From(0), `"0"`},
+		{null.Int64{}, `null`},
+	}
+	for _, test := range marshals {
+		data, err := MarshalInt64JSON(test.v)
+		maybePanic(err)
+		if string(data) != test.want {
+			t.Errorf("MarshalInt64JSON(%v): got %s, want %s", test.v, data, test.want)
+		}
+	}
+
+	unmarshals := []struct {
+		data  string
+		want  int64
+		valid bool
+	}{
+		{`"9223372036854775807"`, math.MaxInt64, true},
+		{`12345`, 12345, true},
+		{` -1 `, -1, true},
+		{`"1e3"`, 1000, true},
+		{`1.0`, 1, true},
+		{`null`, 0, false},
+	}
+	for _, test := range unmarshals {
+		v, err := UnmarshalInt64JSON([]byte(test.data))
+		maybePanic(err)
+		assertValid(t, v.Valid, test.valid, v.Int64 == test.want, "UnmarshalInt64JSON("+test.data+")")
+	}
+
+	for _, data := range []string{`"9223372036854775808"`, `1.5`, `"abc"`, `""`, `"+1"`, `"0x10"`, `true`, `{}`, ``} {
+		if v, err := UnmarshalInt64JSON([]byte(data)); err == nil || v.Valid {
+			t.Errorf("UnmarshalInt64JSON(%s): expected error", data)
+		}
+	}
+}
+
+func TestInt32JSON(t *testing.T) {
+	data, err := MarshalInt32JSON(null.Int32From(-5))
+	maybePanic(err)
+	if string(data) != `-5` {
+		t.Errorf("MarshalInt32JSON(-5): got %s", data)
+	}
+
+	v, err := UnmarshalInt32JSON([]byte(`"2147483647"`))
+	maybePanic(err)
+	assertValid(t, v.Valid, true, v.Int32 == math.MaxInt32, "UnmarshalInt32JSON(string)")
+	v, err = UnmarshalInt32JSON([]byte(`-2.147483648e9`))
+	maybePanic(err)
+	assertValid(t, v.Valid, true, v.Int32 == math.MinInt32, "UnmarshalInt32JSON(exponent)")
+
+	for _, data := range []string{`2147483648`, `-2147483649`, `2.147483648e9`} {
+		if _, err := UnmarshalInt32JSON([]byte(data)); err == nil {
+			t.Errorf("UnmarshalInt32JSON(%s): expected error", data)
+		}
+	}
+}
+
+func TestFloatJSON(t *testing.T) {
+	marshals := []struct {
+		v    float64
+		want string
+	}{
+		{1.5, `1.5`},
+		{1e21, `1e+21`},
+		{1e-7, `1e-7`},
+		{math.NaN(), `"NaN"`},
+		{math.Inf(1), `"Infinity"`},
+		{math.Inf(-1), `"-Infinity"`},
+	}
+	for _, test := range marshals {
+		data, err := MarshalFloatJSON(null.FloatFrom(test.v))
+		maybePanic(err)
+		if string(data) != test.want {
+			t.Errorf("MarshalFloatJSON(%v): got %s, want %s", test.v, data, test.want)
+		}
+		v, err := UnmarshalFloatJSON(data)
+		maybePanic(err)
+		assertValid(t, v.Valid, true, v.Float64 == test.v || math.IsNaN(test.v) && math.IsNaN(v.Float64), "UnmarshalFloatJSON("+string(data)+")")
+	}
+
+	v, err := UnmarshalFloatJSON([]byte(`"-1.25"`))
+	maybePanic(err)
+	assertValid(t, v.Valid, true, v.Float64 == -1.25, "UnmarshalFloatJSON(string)")
+	v, err = UnmarshalFloatJSON([]byte(`null`))
+	maybePanic(err)
+	assertValid(t, v.Valid, false, false, "UnmarshalFloatJSON(null)")
+
+	for _, data := range []string{`"nan"`, `"Inf"`, `1e400`, `"0x1p-2"`, `false`} {
+		if _, err := UnmarshalFloatJSON([]byte(data)); err == nil {
+			t.Errorf("UnmarshalFloatJSON(%s): expected error", data)
+		}
+	}
+}
+
+func TestBoolJSON(t *testing.T) {
+	data, err := MarshalBoolJSON(null.BoolFrom(true))
+	maybePanic(err)
+	if string(data) != `true` {
+		t.Errorf("MarshalBoolJSON(true): got %s", data)
+	}
+
+	v, err := UnmarshalBoolJSON([]byte(`false`))
+	maybePanic(err)
+	assertValid(t, v.Valid, true, !v.Bool, "UnmarshalBoolJSON(false)")
+	v, err = UnmarshalBoolJSON([]byte(`null`))
+	maybePanic(err)
+	assertValid(t, v.Valid, false, false, "UnmarshalBoolJSON(null)")
+
+	for _, data := range []string{`"true"`, `1`, `True`} {
+		if _, err := UnmarshalBoolJSON([]byte(data)); err == nil {
+			t.Errorf("UnmarshalBoolJSON(%s): expected error", data)
+		}
+	}
+}
+
+func TestStringJSON(t *testing.T) {
+	data, err := MarshalStringJSON(null.StringFrom("<a & b>\n"))
+	maybePanic(err)
+	if want := `"<a & b>\n"`; string(data) != want {
+		t.Errorf("MarshalStringJSON: got %s, want %s", data, want)
+	}
+	if _, err := MarshalStringJSON(null.StringFrom("\xff")); err == nil {
+		t.Error("MarshalStringJSON(invalid UTF-8): expected error")
+	}
+
+	v, err := UnmarshalStringJSON(data)
+	maybePanic(err)
+	assertValid(t, v.Valid, true, v.String == "<a & b>\n", "UnmarshalStringJSON")
+	v, err = UnmarshalStringJSON([]byte(`""`))
+	maybePanic(err)
+	assertValid(t, v.Valid, true, v.String == "", "UnmarshalStringJSON(empty)")
+	v, err = UnmarshalStringJSON([]byte(`null`))
+	maybePanic(err)
+	assertValid(t, v.Valid, false, false, "UnmarshalStringJSON(null)")
+
+	for _, data := range []string{`1`, `"unterminated`, `["a"]`} {
+		if _, err := UnmarshalStringJSON([]byte(data)); err == nil {
+			t.Errorf("UnmarshalStringJSON(%s): expected error", data)
+		}
+	}
+}
+
+func TestTimeJSON(t *testing.T) {
+	base := time.Date(2012, 12, 21, 21, 21, 21, 0, time.UTC)
+	marshals := []struct {
+		t    time.Time
+		want string
+	}{
+		{base, `"2012-12-21T21:21:21Z"`},
+		{base.Add(120 * time.Millisecond), `"2012-12-21T21:21:21.120Z"`},
+		{base.Add(123 * time.Microsecond), `"2012-12-21T21:21:21.000123Z"`},
+		{base.Add(5), `"2012-12-21T21:21:21.000000005Z"`},
+		{base.In(time.FixedZone("", 3600)), `"2012-12-21T21:21:21Z"`},
+		{time.Time{}, `"0001-01-01T00:00:00Z"`},
+	}
+	for _, test := range marshals {
+		data, err := MarshalTimeJSON(null.TimeFrom(test.t))
+		maybePanic(err)
+		if string(data) != test.want {
+			t.Errorf("MarshalTimeJSON(%v): got %s, want %s", test.t, data, test.want)
+		}
+		v, err := UnmarshalTimeJSON(data)
+		maybePanic(err)
+		assertValid(t, v.Valid, true, v.Time.Equal(test.t) && v.Time.Location() == time.UTC, "UnmarshalTimeJSON("+string(data)+")")
+	}
+	if _, err := MarshalTimeJSON(null.TimeFrom(time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC))); err == nil {
+		t.Error("MarshalTimeJSON(year 10000): expected error")
+	}
+
+	v, err := UnmarshalTimeJSON([]byte(`"2012-12-21T22:21:21+01:00"`))
+	maybePanic(err)
+	assertValid(t, v.Valid, true, v.Time.Equal(base) && v.Time.Location() == time.UTC, "UnmarshalTimeJSON(offset)")
+	v, err = UnmarshalTimeJSON([]byte(`null`))
+	maybePanic(err)
+	assertValid(t, v.Valid, false, false, "UnmarshalTimeJSON(null)")
+
+	for _, data := range []string{`"2012-12-21"`, `1356124881`, `""`, `"-0001-01-01T00:00:00Z"`} {
+		if _, err := UnmarshalTimeJSON([]byte(data)); err == nil {
+			t.Errorf("UnmarshalTimeJSON(%s): expected error", data)
+		}
+	}
+}
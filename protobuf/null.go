@@ -0,0 +1,193 @@
+package protobuf
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"time"
+	"unicode/utf8"
+
+	"github.com/vitdevelop/null"
+)
+
+// AppendInt64 appends v to b as field num holding a google.protobuf.Int64Value, or nothing if v is null.
+func AppendInt64(b []byte, num int, v null.Int64) []byte {
+	if !v.Valid {
+		return b
+	}
+	return appendVarintWrapper(b, num, uint64(v.Int64))
+}
+
+// ReadInt64 reads the contents of a google.protobuf.Int64Value field, which is always valid.
+func ReadInt64(msg []byte) (null.Int64, error) {
+	var n uint64
+	err := readWrapper(msg, Varint, func(value []byte) (err error) {
+		n, _, err = readVarint(value)
+		return err
+	})
+	if err != nil {
+		return null.Int64{}, err
+	}
+	return null.Int64From(int64(n)), nil
+}
+
+// AppendInt32 appends v to b as field num holding a google.protobuf.Int32Value, or nothing if v is null.
+func AppendInt32(b []byte, num int, v null.Int32) []byte {
+	if !v.Valid {
+		return b
+	}
+	// negative values are sign-extended to 64 bits
+	return appendVarintWrapper(b, num, uint64(int64(v.Int32)))
+}
+
+// ReadInt32 reads the contents of a google.protobuf.Int32Value field, which is always valid.
+// As in other implementations, values that don't fit in an int32 are truncated.
+func ReadInt32(msg []byte) (null.Int32, error) {
+	var n uint64
+	err := readWrapper(msg, Varint, func(value []byte) (err error) {
+		n, _, err = readVarint(value)
+		return err
+	})
+	if err != nil {
+		return null.Int32{}, err
+	}
+	return null.Int32From(int32(n)), nil
+}
+
+// AppendFloat appends v to b as field num holding a google.protobuf.DoubleValue, or nothing if v is null.
+func AppendFloat(b []byte, num int, v null.Float) []byte {
+	if !v.Valid {
+		return b
+	}
+	b = appendTag(b, num, Bytes)
+	bits := math.Float64bits(v.Float64)
+	if bits == 0 {
+		return append(b, 0)
+	}
+	b = appendTag(append(b, 9), 1, Fixed64)
+	return binary.LittleEndian.AppendUint64(b, bits)
+}
+
+// ReadFloat reads the contents of a google.protobuf.DoubleValue field, which is always valid.
+func ReadFloat(msg []byte) (null.Float, error) {
+	var f float64
+	err := readWrapper(msg, Fixed64, func(value []byte) error {
+		f = math.Float64frombits(binary.LittleEndian.Uint64(value))
+		return nil
+	})
+	if err != nil {
+		return null.Float{}, err
+	}
+	return null.FloatFrom(f), nil
+}
+
+// AppendBool appends v to b as field num holding a google.protobuf.BoolValue, or nothing if v is null.
+func AppendBool(b []byte, num int, v null.Bool) []byte {
+	if !v.Valid {
+		return b
+	}
+	if v.Bool {
+		return appendVarintWrapper(b, num, 1)
+	}
+	return appendVarintWrapper(b, num, 0)
+}
+
+// ReadBool reads the contents of a google.protobuf.BoolValue field, which is always valid.
+// Any non-zero varint is true.
+func ReadBool(msg []byte) (null.Bool, error) {
+	var n uint64
+	err := readWrapper(msg, Varint, func(value []byte) (err error) {
+		n, _, err = readVarint(value)
+		return err
+	})
+	if err != nil {
+		return null.Bool{}, err
+	}
+	return null.BoolFrom(n != 0), nil
+}
+
+// AppendString appends v to b as field num holding a google.protobuf.StringValue, or nothing if v is null.
+func AppendString(b []byte, num int, v null.String) []byte {
+	if !v.Valid {
+		return b
+	}
+	b = appendTag(b, num, Bytes)
+	if v.String == "" {
+		return append(b, 0)
+	}
+	b = appendVarint(b, uint64(1+sizeVarint(uint64(len(v.String)))+len(v.String)))
+	b = appendVarint(appendTag(b, 1, Bytes), uint64(len(v.String)))
+	return append(b, v.String...)
+}
+
+// ReadString reads the contents of a google.protobuf.StringValue field, which is always valid.
+// It returns an error if the string is not valid UTF-8, as required for proto3 strings.
+func ReadString(msg []byte) (null.String, error) {
+	var s []byte
+	err := readWrapper(msg, Bytes, func(value []byte) error {
+		if !utf8.Valid(value) {
+			return errors.New("protobuf: invalid UTF-8 in string")
+		}
+		s = value
+		return nil
+	})
+	if err != nil {
+		return null.String{}, err
+	}
+	return null.StringFrom(string(s)), nil
+}
+
+// AppendTime appends v to b as field num holding a google.protobuf.Timestamp, or nothing if v is null.
+// Times outside the range of Timestamp, years 1 to 9999, are appended as is, but ReadTime rejects them.
+func AppendTime(b []byte, num int, v null.Time) []byte {
+	if !v.Valid {
+		return b
+	}
+	sec, nsec := uint64(v.Time.Unix()), uint64(v.Time.Nanosecond())
+	var size int
+	if sec != 0 {
+		size += 1 + sizeVarint(sec)
+	}
+	if nsec != 0 {
+		size += 1 + sizeVarint(nsec)
+	}
+	b = appendVarint(appendTag(b, num, Bytes), uint64(size))
+	if sec != 0 {
+		b = appendVarint(appendTag(b, 1, Varint), sec)
+	}
+	if nsec != 0 {
+		b = appendVarint(appendTag(b, 2, Varint), nsec)
+	}
+	return b
+}
+
+// ReadTime reads the contents of a google.protobuf.Timestamp field, which is always valid.
+// The time is in UTC. It returns an error if the time is outside the range of Timestamp.
+func ReadTime(msg []byte) (null.Time, error) {
+	var sec, nsec int64
+	for len(msg) > 0 {
+		num, typ, value, rest, err := ReadField(msg)
+		if err != nil {
+			return null.Time{}, err
+		}
+		if num == 1 || num == 2 {
+			if typ != Varint {
+				return null.Time{}, typeError(num, typ, Varint)
+			}
+			n, _, err := readVarint(value)
+			if err != nil {
+				return null.Time{}, err
+			}
+			if num == 1 {
+				sec = int64(n)
+			} else {
+				nsec = int64(int32(n))
+			}
+		}
+		msg = rest
+	}
+	if err := checkTime(sec, nsec); err != nil {
+		return null.Time{}, err
+	}
+	return null.TimeFrom(time.Unix(sec, nsec).UTC()), nil
+}
@@ -0,0 +1,186 @@
+package protobuf
+
+import (
+	"encoding/hex"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/vitdevelop/null"
+)
+
+func TestNullRoundTrip(t *testing.T) {
+	created := time.Date(2012, 12, 21, 21, 21, 21, 123456789, time.UTC)
+	for _, valid := range []bool{true, false} {
+		var b []byte
+		b = AppendInt64(b, 1, null.NewInt64(math.MinInt64, valid))
+		b = AppendInt32(b, 2, null.NewInt32(math.MinInt32, valid))
+		b = AppendFloat(b, 3, null.NewFloat(1.2345, valid))
+		b = AppendBool(b, 4, null.NewBool(true, valid))
+		b = AppendString(b, 5, null.NewString("test", valid))
+		b = AppendTime(b, 1000, null.NewTime(created, valid))
+
+		var (
+			i   null.Int64
+			i32 null.Int32
+			f   null.Float
+			bo  null.Bool
+			s   null.String
+			ti  null.Time
+		)
+		for len(b) > 0 {
+			num, typ, v, rest, err := ReadField(b)
+			maybePanic(err)
+			if typ != Bytes {
+				t.Fatalf("field %d: got %v, want bytes", num, typ)
+			}
+			switch num {
+			case 1:
+				i, err = ReadInt64(v)
+			case 2:
+				i32, err = ReadInt32(v)
+			case 3:
+				f, err = ReadFloat(v)
+			case 4:
+				bo, err = ReadBool(v)
+			case 5:
+				s, err = ReadString(v)
+			case 1000:
+				ti, err = ReadTime(v)
+			default:
+				t.Errorf("unexpected field %d", num)
+			}
+			maybePanic(err)
+			b = rest
+		}
+
+		assertValid(t, i.Valid, valid, i.Int64 == math.MinInt64, "Int64")
+		assertValid(t, i32.Valid, valid, i32.Int32 == math.MinInt32, "Int32")
+		assertValid(t, f.Valid, valid, f.Float64 == 1.2345, "Float")
+		assertValid(t, bo.Valid, valid, bo.Bool, "Bool")
+		assertValid(t, s.Valid, valid, s.String == "test", "String")
+		assertValid(t, ti.Valid, valid, ti.Time.Equal(created) && ti.Time.Location() == time.UTC, "Time")
+	}
+}
+
+func TestAppend(t *testing.T) {
+	tests := []struct {
+		b    []byte
+		want string
+	}{
+		{AppendInt64(nil, 1, null.Int64From(150)), "0a03089601"},
+		{AppendInt64(nil, 1, null.Int64From(-1)), "0a0b08ffffffffffffffffff01"},
+		{AppendInt64(nil, 1, null.Int64From(0)), "0a00"},
+		{AppendInt64(nil, 1, null.Int64{}), ""},
+		{AppendInt32(nil, 2, null.Int32From(-1)), "120b08ffffffffffffffffff01"},
+		{AppendInt32(nil, 2, null.Int32{}), ""},
+		{AppendFloat(nil, 3, null.FloatFrom(1)), "1a0909000000000000f03f"},
+		{AppendFloat(nil, 3, null.FloatFrom(0)), "1a00"},
+		{AppendFloat(nil, 3, null.FloatFrom(math.Copysign(0, -1))), "1a09090000000000000080"},
+		{AppendBool(nil, 4, null.BoolFrom(true)), "22020801"},
+		{AppendBool(nil, 4, null.BoolFrom(false)), "2200"},
+		{AppendString(nil, 5, null.StringFrom("hi")), "2a040a026869"},
+		{AppendString(nil, 5, null.StringFrom("")), "2a00"},
+		{AppendTime(nil, 16, null.TimeFrom(time.Unix(1, 5))), "8201040801" + "1005"},
+		{AppendTime(nil, 16, null.TimeFrom(time.Unix(0, 0))), "820100"},
+		{AppendTime(nil, 16, null.Time{}), ""},
+	}
+	for _, test := range tests {
+		if got := hex.EncodeToString(test.b); got != test.want {
+			t.Errorf("got %s, want %s", got, test.want)
+		}
+	}
+}
+
+func TestReadWrapper(t *testing.T) {
+	// a field that appears more than once takes its last value, and unknown fields are skipped
+	i, err := ReadInt64(decodeHex("0801" + "1a0178" + "0802"))
+	maybePanic(err)
+	assertValid(t, i.Valid, true, i.Int64 == 2, "Int64 merged")
+	// an empty message holds the default value
+	s, err := ReadString(nil)
+	maybePanic(err)
+	assertValid(t, s.Valid, true, s.String == "", "empty String")
+	// Int32 values are truncated
+	i32, err := ReadInt32(decodeHex("0880808080f0ffffffff01"))
+	maybePanic(err)
+	assertValid(t, i32.Valid, true, i32.Int32 == 0, "truncated Int32")
+	bo, err := ReadBool(decodeHex("0802"))
+	maybePanic(err)
+	assertValid(t, bo.Valid, true, bo.Bool, "Bool from 2")
+	ti, err := ReadTime(decodeHex("10" + "05" + "08" + "01"))
+	maybePanic(err)
+	assertValid(t, ti.Valid, true, ti.Time.Equal(time.Unix(1, 5)), "Time fields out of order")
+}
+
+func TestReadErrors(t *testing.T) {
+	wrongType := []struct {
+		name string
+		read func([]byte) error
+		s    string
+	}{
+		{"Int64", readErr(ReadInt64), "0a0101"},
+		{"Int32", readErr(ReadInt32), "090000000000000000"},
+		{"Float", readErr(ReadFloat), "0801"},
+		{"Bool", readErr(ReadBool), "0d00000000"},
+		{"String", readErr(ReadString), "0801"},
+		{"Time seconds", readErr(ReadTime), "0a00"},
+		{"Time nanos", readErr(ReadTime), "1500000000"},
+	}
+	for _, test := range wrongType {
+		if err := test.read(decodeHex(test.s)); !errors.Is(err, ErrType) {
+			t.Errorf("%s(%s): got %v, want ErrType", test.name, test.s, err)
+		}
+	}
+
+	invalid := []struct {
+		name string
+		read func([]byte) error
+		s    string
+	}{
+		{"Int64 short", readErr(ReadInt64), "0880"},
+		{"Float short", readErr(ReadFloat), "0900"},
+		{"String short", readErr(ReadString), "0a0568"},
+		{"String UTF-8", readErr(ReadString), "0a01ff"},
+		{"Time too early", readErr(ReadTime), timeMessage(time.Date(0, 12, 31, 23, 59, 59, 0, time.UTC))},
+		{"Time too late", readErr(ReadTime), timeMessage(time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC))},
+		{"Time negative nanos", readErr(ReadTime), "10ffffffffffffffffff01"},
+		{"Time too many nanos", readErr(ReadTime), hex.EncodeToString(appendVarint([]byte{0x10}, 1e9))},
+	}
+	for _, test := range invalid {
+		if err := test.read(decodeHex(test.s)); err == nil {
+			t.Errorf("%s(%s): expected error", test.name, test.s)
+		}
+	}
+}
+
+func readErr[T any](read func([]byte) (T, error)) func([]byte) error {
+	return func(b []byte) error {
+		_, err := read(b)
+		return err
+	}
+}
+
+// timeMessage returns the contents of a google.protobuf.Timestamp holding t, in hex.
+func timeMessage(t time.Time) string {
+	_, _, v, _, err := ReadField(AppendTime(nil, 1, null.TimeFrom(t)))
+	maybePanic(err)
+	return hex.EncodeToString(v)
+}
+
+func assertValid(t *testing.T, valid, wantValid, valueOK bool, from string) {
+	t.Helper()
+	if valid != wantValid {
+		t.Errorf("%s: got valid %v, want %v", from, valid, wantValid)
+	}
+	if valid && !valueOK {
+		t.Errorf("%s: bad value", from)
+	}
+}
+
+func maybePanic(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,234 @@
+// Package protobuf encodes and decodes the types of github.com/vitdevelop/null as the
+// Protocol Buffers well-known wrapper messages, in both the binary wire format and the canonical JSON mapping,
+// without reflection or dependencies.
+//
+// The types map to messages as follows, with a null value being an absent message field:
+//
+//	null.Int64   google.protobuf.Int64Value
+//	null.Int32   google.protobuf.Int32Value
+//	null.Float   google.protobuf.DoubleValue
+//	null.Bool    google.protobuf.BoolValue
+//	null.String  google.protobuf.StringValue
+//	null.Time    google.protobuf.Timestamp
+//
+// For every type there is an Append function, which appends a message field with the given field number
+// holding a valid value, or nothing if the value is null,
+// and a Read function, which decodes the contents of such a field into a valid value.
+// ReadField splits an enclosing message into its fields:
+//
+//	b = protobuf.AppendInt64(b, 1, id)
+//	b = protobuf.AppendString(b, 2, name)
+//	...
+//	for len(b) > 0 {
+//		num, typ, v, rest, err := protobuf.ReadField(b)
+//		if err != nil {
+//			return err
+//		}
+//		switch {
+//		case num == 1 && typ == protobuf.Bytes:
+//			id, err = protobuf.ReadInt64(v)
+//		case num == 2 && typ == protobuf.Bytes:
+//			name, err = protobuf.ReadString(v)
+//		}
+//		...
+//		b = rest
+//	}
+//
+// Times are encoded as seconds and nanoseconds since the Unix epoch, so they are decoded in UTC.
+//
+// The JSON functions, such as MarshalInt64JSON and UnmarshalInt64JSON, use the protojson representation:
+// Int64 is a decimal string, Float may be "NaN", "Infinity" or "-Infinity",
+// Time is an RFC 3339 string in UTC, and null is null.
+package protobuf
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	// ErrShortBytes is returned when the input ends in the middle of a field.
+	ErrShortBytes = errors.New("protobuf: too few bytes left to read field")
+	// ErrOverflow is returned when a varint is longer than 64 bits.
+	ErrOverflow = errors.New("protobuf: varint overflow")
+	// ErrType is returned, wrapped, when a field of a wrapper message has an unexpected wire type.
+	ErrType = errors.New("protobuf: unexpected wire type")
+)
+
+// WireType is the wire type of a field, which tells how its value is encoded.
+type WireType int8
+
+// Wire types. The deprecated group wire types are not supported.
+const (
+	Varint  WireType = 0
+	Fixed64 WireType = 1
+	Bytes   WireType = 2
+	Fixed32 WireType = 5
+)
+
+func (t WireType) String() string {
+	switch t {
+	case Varint:
+		return "varint"
+	case Fixed64:
+		return "fixed64"
+	case Bytes:
+		return "bytes"
+	case Fixed32:
+		return "fixed32"
+	}
+	return fmt.Sprintf("wire type %d", int8(t))
+}
+
+// maxFieldNumber is the largest valid field number.
+const maxFieldNumber = 1<<29 - 1
+
+// range of google.protobuf.Timestamp: 0001-01-01T00:00:00Z to 9999-12-31T23:59:59.999999999Z
+const (
+	minSeconds = -62135596800
+	maxSeconds = 253402300799
+)
+
+// ReadField reads the field at the start of b, returning its number, its wire type,
+// its value and the remaining bytes. The value of a Varint field is the encoded varint,
+// and the value of a Bytes field is its contents, without the length.
+func ReadField(b []byte) (num int, typ WireType, value []byte, rest []byte, err error) {
+	tag, n, err := readVarint(b)
+	if err != nil {
+		return 0, 0, nil, b, err
+	}
+	if tag>>3 < 1 || tag>>3 > maxFieldNumber {
+		return 0, 0, nil, b, fmt.Errorf("protobuf: invalid field number %d", tag>>3)
+	}
+	num, typ = int(tag>>3), WireType(tag&7)
+
+	var size int
+	switch typ {
+	case Varint:
+		m, err := varintLen(b[n:])
+		if err != nil {
+			return 0, 0, nil, b, err
+		}
+		return num, typ, b[n : n+m], b[n+m:], nil
+	case Fixed64:
+		size = 8
+	case Fixed32:
+		size = 4
+	case Bytes:
+		length, m, err := readVarint(b[n:])
+		if err != nil {
+			return 0, 0, nil, b, err
+		}
+		if length > uint64(len(b[n+m:])) {
+			return 0, 0, nil, b, ErrShortBytes
+		}
+		n, size = n+m, int(length)
+	default:
+		return 0, 0, nil, b, fmt.Errorf("protobuf: unsupported %s", typ)
+	}
+	if err := need(b[n:], size); err != nil {
+		return 0, 0, nil, b, err
+	}
+	return num, typ, b[n : n+size], b[n+size:], nil
+}
+
+// need returns ErrShortBytes if b has less than n bytes.
+func need(b []byte, n int) error {
+	if len(b) < n {
+		return ErrShortBytes
+	}
+	return nil
+}
+
+// typeError returns an error for field num having wire type got instead of want.
+func typeError(num int, got, want WireType) error {
+	return fmt.Errorf("%w: field %d has %s, need %s", ErrType, num, got, want)
+}
+
+func appendVarint(b []byte, v uint64) []byte {
+	for v >= 0x80 {
+		b = append(b, byte(v)|0x80)
+		v >>= 7
+	}
+	return append(b, byte(v))
+}
+
+// sizeVarint returns the number of bytes appendVarint appends for v.
+func sizeVarint(v uint64) int {
+	n := 1
+	for v >= 0x80 {
+		v >>= 7
+		n++
+	}
+	return n
+}
+
+func appendTag(b []byte, num int, typ WireType) []byte {
+	return appendVarint(b, uint64(num)<<3|uint64(typ))
+}
+
+// readVarint reads a varint from the start of b, returning it and its length in bytes.
+func readVarint(b []byte) (uint64, int, error) {
+	var v uint64
+	for i := 0; i < len(b); i++ {
+		c := b[i]
+		if i == 9 && c > 1 {
+			return 0, 0, ErrOverflow
+		}
+		v |= uint64(c&0x7f) << (7 * i)
+		if c < 0x80 {
+			return v, i + 1, nil
+		}
+	}
+	return 0, 0, ErrShortBytes
+}
+
+// varintLen returns the length in bytes of the varint at the start of b.
+func varintLen(b []byte) (int, error) {
+	_, n, err := readVarint(b)
+	return n, err
+}
+
+// appendVarintWrapper appends field num holding a wrapper message with v as a varint in field 1.
+// As in proto3, field 1 is left out if v is 0.
+func appendVarintWrapper(b []byte, num int, v uint64) []byte {
+	b = appendTag(b, num, Bytes)
+	if v == 0 {
+		return append(b, 0)
+	}
+	b = appendVarint(b, uint64(1+sizeVarint(v)))
+	return appendVarint(appendTag(b, 1, Varint), v)
+}
+
+// readWrapper reads the contents of a wrapper message, calling f with the value of every field 1,
+// which must have wire type typ. Other fields are skipped.
+func readWrapper(msg []byte, typ WireType, f func(value []byte) error) error {
+	for len(msg) > 0 {
+		num, got, value, rest, err := ReadField(msg)
+		if err != nil {
+			return err
+		}
+		if num == 1 {
+			if got != typ {
+				return typeError(num, got, typ)
+			}
+			if err := f(value); err != nil {
+				return err
+			}
+		}
+		msg = rest
+	}
+	return nil
+}
+
+// checkTime returns an error if sec and nsec are outside the range of google.protobuf.Timestamp.
+func checkTime(sec int64, nsec int64) error {
+	if sec < minSeconds || sec > maxSeconds {
+		return fmt.Errorf("protobuf: timestamp seconds %d out of range", sec)
+	}
+	if nsec < 0 || nsec >= int64(time.Second) {
+		return fmt.Errorf("protobuf: invalid timestamp nanoseconds %d", nsec)
+	}
+	return nil
+}
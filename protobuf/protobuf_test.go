@@ -0,0 +1,110 @@
+package protobuf
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestVarint(t *testing.T) {
+	tests := []struct {
+		v    uint64
+		want string
+	}{
+		{0, "00"},
+		{1, "01"},
+		{127, "7f"},
+		{128, "8001"},
+		{150, "9601"},
+		{16384, "808001"},
+		{math.MaxInt64, "ffffffffffffffff7f"},
+		{math.MaxUint64, "ffffffffffffffffff01"},
+	}
+	for _, test := range tests {
+		b := appendVarint(nil, test.v)
+		if got := hex.EncodeToString(b); got != test.want {
+			t.Errorf("appendVarint(%d): got %s, want %s", test.v, got, test.want)
+		}
+		if n := sizeVarint(test.v); n != len(b) {
+			t.Errorf("sizeVarint(%d): got %d, want %d", test.v, n, len(b))
+		}
+		v, n, err := readVarint(append(b, 0x01))
+		if err != nil || v != test.v || n != len(b) {
+			t.Errorf("readVarint(% x): got %d, %d, %v", b, v, n, err)
+		}
+	}
+
+	if _, _, err := readVarint(decodeHex("ffffffffffffffffff02")); !errors.Is(err, ErrOverflow) {
+		t.Errorf("readVarint(65 bits): got %v, want ErrOverflow", err)
+	}
+	if _, _, err := readVarint(decodeHex("ffffffffffffffffffff01")); !errors.Is(err, ErrOverflow) {
+		t.Errorf("readVarint(11 bytes): got %v, want ErrOverflow", err)
+	}
+	if _, _, err := readVarint(decodeHex("8080")); !errors.Is(err, ErrShortBytes) {
+		t.Errorf("readVarint(unterminated): got %v, want ErrShortBytes", err)
+	}
+}
+
+func TestReadField(t *testing.T) {
+	tests := []struct {
+		s     string
+		num   int
+		typ   WireType
+		value string
+	}{
+		{"089601", 1, Varint, "9601"},
+		{"110000000000000040", 2, Fixed64, "0000000000000040"},
+		{"1d0000803f", 3, Fixed32, "0000803f"},
+		{"2203616263", 4, Bytes, "616263"},
+		{"2200", 4, Bytes, ""},
+		{"f8ffffff0f01", maxFieldNumber, Varint, "01"},
+	}
+	for _, test := range tests {
+		b := append(decodeHex(test.s), 0x08)
+		num, typ, value, rest, err := ReadField(b)
+		if err != nil || num != test.num || typ != test.typ || hex.EncodeToString(value) != test.value || !bytes.Equal(rest, []byte{0x08}) {
+			t.Errorf("ReadField(%s): got %d, %v, % x, % x, %v", test.s, num, typ, value, rest, err)
+		}
+	}
+
+	invalid := []struct {
+		name string
+		s    string
+		err  error
+	}{
+		{"empty", "", ErrShortBytes},
+		{"short varint", "0880", ErrShortBytes},
+		{"short fixed64", "0900000000", ErrShortBytes},
+		{"short fixed32", "0d000000", ErrShortBytes},
+		{"short bytes", "0a0361", ErrShortBytes},
+		{"long length", "0affffffffffffffffff01", ErrShortBytes},
+		{"field 0", "0001", nil},
+		{"field too large", "8080808010", nil},
+		{"group", "0b", nil},
+		{"wire type 6", "0e", nil},
+	}
+	for _, test := range invalid {
+		b := decodeHex(test.s)
+		_, _, _, rest, err := ReadField(b)
+		if err == nil || (test.err != nil && !errors.Is(err, test.err)) || !bytes.Equal(rest, b) {
+			t.Errorf("ReadField(%s): got %v, want error %v", test.name, err, test.err)
+		}
+	}
+}
+
+func TestReadWrapperType(t *testing.T) {
+	err := readWrapper(decodeHex("0d00000000"), Varint, func([]byte) error { return nil })
+	if got, want := err.Error(), "protobuf: unexpected wire type: field 1 has fixed32, need varint"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func decodeHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
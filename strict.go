@@ -0,0 +1,139 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// StrictInt64 is a nullable int64 that only accepts JSON numbers and null.
+// It behaves exactly like Int64, except that UnmarshalJSON rejects strings such as "123",
+// which Int64 accepts, as well as whitespace around the number.
+// Use it for public APIs, where quoted numbers usually hide a client bug.
+type StrictInt64 struct {
+	Int64
+}
+
+// NewStrictInt64 creates a new StrictInt64
+func NewStrictInt64(i int64, valid bool) StrictInt64 {
+	return StrictInt64{Int64: NewInt64(i, valid)}
+}
+
+// StrictInt64From creates a new StrictInt64 that will always be valid.
+func StrictInt64From(i int64) StrictInt64 {
+	return NewStrictInt64(i, true)
+}
+
+// StrictInt64FromPtr creates a new StrictInt64 that will be null if i is nil.
+func StrictInt64FromPtr(i *int64) StrictInt64 {
+	return StrictInt64{Int64: Int64FromPtr(i)}
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports integer and null input. Strings, fractions and exponents are rejected.
+func (i *StrictInt64) UnmarshalJSON(data []byte) error {
+	if err := checkStrictNumber(data, true, "int", reflect.TypeFor[int64]()); err != nil {
+		return err
+	}
+	return i.Int64.UnmarshalJSON(data)
+}
+
+// StrictInt32 is a nullable int32 that only accepts JSON numbers and null.
+// It behaves exactly like Int32, except that UnmarshalJSON rejects strings such as "123",
+// which Int32 accepts, as well as whitespace around the number.
+type StrictInt32 struct {
+	Int32
+}
+
+// NewStrictInt32 creates a new StrictInt32
+func NewStrictInt32(i int32, valid bool) StrictInt32 {
+	return StrictInt32{Int32: NewInt32(i, valid)}
+}
+
+// StrictInt32From creates a new StrictInt32 that will always be valid.
+func StrictInt32From(i int32) StrictInt32 {
+	return NewStrictInt32(i, true)
+}
+
+// StrictInt32FromPtr creates a new StrictInt32 that will be null if i is nil.
+func StrictInt32FromPtr(i *int32) StrictInt32 {
+	return StrictInt32{Int32: Int32FromPtr(i)}
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports integer and null input. Strings, fractions and exponents are rejected.
+func (i *StrictInt32) UnmarshalJSON(data []byte) error {
+	if err := checkStrictNumber(data, true, "int", reflect.TypeFor[int32]()); err != nil {
+		return err
+	}
+	return i.Int32.UnmarshalJSON(data)
+}
+
+// StrictFloat is a nullable float64 that only accepts JSON numbers and null.
+// It behaves exactly like Float, except that UnmarshalJSON rejects strings such as "1.5",
+// which Float accepts, as well as whitespace around the number.
+// This includes the strings written by FloatNaNAsString.
+type StrictFloat struct {
+	Float
+}
+
+// NewStrictFloat creates a new StrictFloat
+func NewStrictFloat(f float64, valid bool) StrictFloat {
+	return StrictFloat{Float: NewFloat(f, valid)}
+}
+
+// StrictFloatFrom creates a new StrictFloat that will always be valid.
+func StrictFloatFrom(f float64) StrictFloat {
+	return NewStrictFloat(f, true)
+}
+
+// StrictFloatFromPtr creates a new StrictFloat that will be null if f is nil.
+func StrictFloatFromPtr(f *float64) StrictFloat {
+	return StrictFloat{Float: FloatFromPtr(f)}
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number and null input. Strings are rejected.
+func (f *StrictFloat) UnmarshalJSON(data []byte) error {
+	if err := checkStrictNumber(data, false, "float", reflect.TypeFor[float64]()); err != nil {
+		return err
+	}
+	return f.Float.UnmarshalJSON(data)
+}
+
+// checkStrictNumber returns an error explaining the JSON type needed if data is valid JSON,
+// but not null or a number that the strict types accept: an integer that fits in typ if integer is true.
+// Invalid JSON is left to the UnmarshalJSON of the embedded type, for its syntax error.
+func checkStrictNumber(data []byte, integer bool, need string, typ reflect.Type) error {
+	if bytes.Equal(data, nullBytes) || (!integer && isJSONNumber(data)) {
+		return nil
+	}
+	if integer && isJSONInt(data) {
+		if _, ok := parseJSONInt(data, typ.Bits()); !ok {
+			return fmt.Errorf("null: JSON input is out of range (need %s): %w", need, &json.UnmarshalTypeError{Value: "number " + string(data), Type: typ})
+		}
+		return nil
+	}
+	if !json.Valid(data) {
+		return nil
+	}
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) != len(data) {
+		return fmt.Errorf("null: JSON input has whitespace around the value (need %s)", need)
+	}
+	var value string
+	switch trimmed[0] {
+	case '"':
+		value = "string"
+	case 't', 'f':
+		value = "bool"
+	case '[':
+		value = "array"
+	case '{':
+		value = "object"
+	default:
+		value = "number " + string(trimmed)
+	}
+	return fmt.Errorf("null: JSON input is invalid type (need %s): %w", need, &json.UnmarshalTypeError{Value: value, Type: typ})
+}
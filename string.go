@@ -6,8 +6,10 @@ package null
 
 import (
 	"bytes"
+	"cmp"
 	"database/sql"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
@@ -41,6 +43,23 @@ func (s String) ValueOrZero() string {
 	return s.String
 }
 
+// OrElse returns the inner value if valid, otherwise v.
+func (s String) OrElse(v string) string {
+	if !s.Valid {
+		return v
+	}
+	return s.String
+}
+
+// OrElseGet returns the inner value if valid, otherwise the result of calling f.
+// f is only called if this String is null.
+func (s String) OrElseGet(f func() string) string {
+	if !s.Valid {
+		return f()
+	}
+	return s.String
+}
+
 // NewString creates a new String
 func NewString(s string, valid bool) String {
 	return String{
@@ -59,6 +78,12 @@ func (s *String) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
+	if str, ok := unquoteJSONString(data); ok {
+		s.String = str
+		s.Valid = true
+		return nil
+	}
+
 	if err := json.Unmarshal(data, &s.String); err != nil {
 		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
 	}
@@ -70,19 +95,31 @@ func (s *String) UnmarshalJSON(data []byte) error {
 // MarshalJSON implements json.Marshaler.
 // It will encode null if this String is null.
 func (s String) MarshalJSON() ([]byte, error) {
+	return s.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of s to dst, the same as MarshalJSON.
+// Like encoding/json, it escapes HTML characters and replaces invalid UTF-8.
+func (s String) AppendJSON(dst []byte) ([]byte, error) {
 	if !s.Valid {
-		return []byte("null"), nil
+		return append(dst, nullBytes...), nil
 	}
-	return json.Marshal(s.String)
+	return appendJSONString(dst, s.String), nil
 }
 
 // MarshalText implements encoding.TextMarshaler.
 // It will encode a blank string when this String is null.
 func (s String) MarshalText() ([]byte, error) {
+	return s.AppendText([]byte{})
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append nothing if this String is null.
+func (s String) AppendText(dst []byte) ([]byte, error) {
 	if !s.Valid {
-		return []byte{}, nil
+		return dst, nil
 	}
-	return []byte(s.String), nil
+	return append(dst, s.String...), nil
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
@@ -93,6 +130,88 @@ func (s *String) UnmarshalText(text []byte) error {
 	return nil
 }
 
+// MarshalXML implements xml.Marshaler.
+// It will encode null as an empty element; see XMLOmit and XMLNil for other encodings.
+func (s String) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, xmlNullEmpty, !s.Valid, s)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// It will unmarshal to a null String if the element is empty or has xsi:nil="true".
+func (s *String) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, s)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will encode null as an empty attribute; see XMLOmit and XMLNil to omit it.
+func (s String) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, xmlNullEmpty, !s.Valid, s)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+// It will unmarshal to a null String if the attribute is empty.
+func (s *String) UnmarshalXMLAttr(attr xml.Attr) error {
+	return s.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It encodes a version byte and a validity byte; valid values are followed by the bytes of the string.
+func (s String) MarshalBinary() ([]byte, error) {
+	if !s.Valid {
+		return binaryNull(), nil
+	}
+	return append(binaryHeader(len(s.String)), s.String...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// It decodes the encoding produced by MarshalBinary.
+func (s *String) UnmarshalBinary(data []byte) error {
+	value, valid, err := readBinary(data, -1)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		*s = NewString("", false)
+		return nil
+	}
+	*s = NewString(string(value), true)
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using MarshalBinary.
+func (s String) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using UnmarshalBinary.
+func (s *String) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
+
+// MarshalYAML implements yaml.Marshaler from gopkg.in/yaml.v3 and compatible packages.
+// It will encode null if this String is null.
+func (s String) MarshalYAML() (any, error) {
+	if !s.Valid {
+		return nil, nil
+	}
+	return s.String, nil
+}
+
+// UnmarshalYAML implements the unmarshaler interface of gopkg.in/yaml.v2, which gopkg.in/yaml.v3 also supports.
+// It supports string and null input.
+func (s *String) UnmarshalYAML(unmarshal func(any) error) error {
+	var v *string
+	if err := unmarshal(&v); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal YAML: %w", err)
+	}
+	if v == nil {
+		*s = NewString("", false)
+		return nil
+	}
+	*s = StringFrom(*v)
+	return nil
+}
+
 // SetValid changes this String's value and also sets it to be non-null.
 func (s *String) SetValid(v string) {
 	s.String = v
@@ -116,3 +235,13 @@ func (s String) IsZero() bool {
 func (s String) Equal(other String) bool {
 	return s.Valid == other.Valid && (!s.Valid || s.String == other.String)
 }
+
+// Compare returns an integer comparing two Strings, for use with slices.SortFunc and similar.
+// The result is -1 if s < other, 0 if s == other, and +1 if s > other.
+// Null is considered less than any valid value; use NullsLast for the opposite order.
+func (s String) Compare(other String) int {
+	if !s.Valid || !other.Valid {
+		return compareBool(s.Valid, other.Valid)
+	}
+	return cmp.Compare(s.String, other.String)
+}
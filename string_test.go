@@ -1,9 +1,11 @@
 package null
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"testing"
+	"unicode/utf8"
 )
 
 var (
@@ -255,3 +257,39 @@ func assertStringEqualIsFalse(t *testing.T, a, b String) {
 		t.Errorf("Equal() of String{\"%v\", Valid:%t} and String{\"%v\", Valid:%t} should return false", a.String, a.Valid, b.String, b.Valid)
 	}
 }
+
+func TestStringAppendJSON(t *testing.T) {
+	var ascii []byte
+	for c := 0; c < utf8.RuneSelf; c++ {
+		ascii = append(ascii, byte(c))
+	}
+	tests := []string{"", "test", "<a href=\"x\">&amp;</a>", "tab\there\\", "é日本  ", string(ascii), "\xffinvalid\xe6\x97", "\xed\xa0\x80"}
+	for _, s := range tests {
+		got, err := StringFrom(s).AppendJSON([]byte("prefix"))
+		maybePanic(err)
+		want, err := json.Marshal(s)
+		maybePanic(err)
+		got, ok := bytes.CutPrefix(got, []byte("prefix"))
+		if !ok {
+			t.Errorf("AppendJSON(%q): got %s, want prefix kept", s, got)
+			continue
+		}
+		if !utf8.ValidString(s) {
+			// encoding/json escapes U+FFFD as \ufffd without json/v2, so compare the decoded strings
+			var gotStr, wantStr string
+			maybePanic(json.Unmarshal(got, &gotStr))
+			maybePanic(json.Unmarshal(want, &wantStr))
+			got, want = []byte(gotStr), []byte(wantStr)
+		}
+		if string(got) != string(want) {
+			t.Errorf("AppendJSON(%q): got %s, want %s", s, got, want)
+		}
+	}
+
+	got, err := NewString("test", false).AppendJSON([]byte("prefix"))
+	maybePanic(err)
+	assertJSONEquals(t, got, "prefixnull", "null AppendJSON")
+	got, err = NewString("test", false).AppendText([]byte("prefix"))
+	maybePanic(err)
+	assertJSONEquals(t, got, "prefix", "null AppendText")
+}
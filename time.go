@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 	"time"
 )
@@ -54,13 +55,35 @@ func (t Time) ValueOrZero() time.Time {
 	return t.Time
 }
 
+// OrElse returns the inner value if valid, otherwise v.
+func (t Time) OrElse(v time.Time) time.Time {
+	if !t.Valid {
+		return v
+	}
+	return t.Time
+}
+
+// OrElseGet returns the inner value if valid, otherwise the result of calling f.
+// f is only called if this Time is null.
+func (t Time) OrElseGet(f func() time.Time) time.Time {
+	if !t.Valid {
+		return f()
+	}
+	return t.Time
+}
+
 // MarshalJSON implements json.Marshaler.
 // It will encode null if this time is null.
 func (t Time) MarshalJSON() ([]byte, error) {
+	return t.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of t to dst, the same as MarshalJSON.
+func (t Time) AppendJSON(dst []byte) ([]byte, error) {
 	if !t.Valid {
-		return []byte("null"), nil
+		return append(dst, nullBytes...), nil
 	}
-	return t.Time.MarshalJSON()
+	return appendTimeJSON(dst, t.Time)
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
@@ -71,6 +94,15 @@ func (t *Time) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
+	if len(data) > 0 && data[0] == '"' {
+		var v time.Time
+		if err := v.UnmarshalJSON(data); err == nil {
+			t.Time = v
+			t.Valid = true
+			return nil
+		}
+	}
+
 	if err := json.Unmarshal(data, &t.Time); err != nil {
 		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
 	}
@@ -82,10 +114,16 @@ func (t *Time) UnmarshalJSON(data []byte) error {
 // MarshalText implements encoding.TextMarshaler.
 // It returns an empty string if invalid, otherwise time.Time's MarshalText.
 func (t Time) MarshalText() ([]byte, error) {
+	return t.AppendText([]byte{})
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends nothing if invalid, otherwise the same as time.Time's AppendText.
+func (t Time) AppendText(dst []byte) ([]byte, error) {
 	if !t.Valid {
-		return []byte{}, nil
+		return dst, nil
 	}
-	return t.Time.MarshalText()
+	return t.Time.AppendText(dst)
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
@@ -105,6 +143,96 @@ func (t *Time) UnmarshalText(text []byte) error {
 	return nil
 }
 
+// MarshalXML implements xml.Marshaler.
+// It will encode null as an empty element; see XMLOmit and XMLNil for other encodings.
+func (t Time) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, xmlNullEmpty, !t.Valid, t)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// It will unmarshal to a null Time if the element is empty or has xsi:nil="true".
+func (t *Time) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, t)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will encode null as an empty attribute; see XMLOmit and XMLNil to omit it.
+func (t Time) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, xmlNullEmpty, !t.Valid, t)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+// It will unmarshal to a null Time if the attribute is empty.
+func (t *Time) UnmarshalXMLAttr(attr xml.Attr) error {
+	return t.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It encodes a version byte and a validity byte; valid values are followed by the encoding of time.Time.MarshalBinary, which includes the zone offset.
+func (t Time) MarshalBinary() ([]byte, error) {
+	if !t.Valid {
+		return binaryNull(), nil
+	}
+	data, err := t.Time.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("null: couldn't marshal binary: %w", err)
+	}
+	return append(binaryHeader(len(data)), data...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// It decodes the encoding produced by MarshalBinary.
+func (t *Time) UnmarshalBinary(data []byte) error {
+	value, valid, err := readBinary(data, -1)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		*t = NewTime(time.Time{}, false)
+		return nil
+	}
+	var v time.Time
+	if err := v.UnmarshalBinary(value); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal binary: %w", err)
+	}
+	*t = NewTime(v, true)
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using MarshalBinary.
+func (t Time) GobEncode() ([]byte, error) {
+	return t.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using UnmarshalBinary.
+func (t *Time) GobDecode(data []byte) error {
+	return t.UnmarshalBinary(data)
+}
+
+// MarshalYAML implements yaml.Marshaler from gopkg.in/yaml.v3 and compatible packages.
+// It will encode null if this Time is null.
+func (t Time) MarshalYAML() (any, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	return t.Time, nil
+}
+
+// UnmarshalYAML implements the unmarshaler interface of gopkg.in/yaml.v2, which gopkg.in/yaml.v3 also supports.
+// It supports timestamp and null input.
+func (t *Time) UnmarshalYAML(unmarshal func(any) error) error {
+	var v *time.Time
+	if err := unmarshal(&v); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal YAML: %w", err)
+	}
+	if v == nil {
+		*t = NewTime(time.Time{}, false)
+		return nil
+	}
+	*t = TimeFrom(*v)
+	return nil
+}
+
 // SetValid changes this Time's value and sets it to be non-null.
 func (t *Time) SetValid(v time.Time) {
 	t.Time = v
@@ -132,9 +260,31 @@ func (t Time) Equal(other Time) bool {
 	return t.Valid == other.Valid && (!t.Valid || t.Time.Equal(other.Time))
 }
 
+// Compare returns an integer comparing two Times, for use with slices.SortFunc and similar.
+// The result is -1 if t < other, 0 if t == other, and +1 if t > other.
+// Times are ordered by the instant they represent, consistent with Equal.
+// Null is considered less than any valid value; use NullsLast for the opposite order.
+func (t Time) Compare(other Time) int {
+	if !t.Valid || !other.Valid {
+		return compareBool(t.Valid, other.Valid)
+	}
+	return t.Time.Compare(other.Time)
+}
+
 // ExactEqual returns true if both Time objects are equal or both null.
 // ExactEqual returns false for times that are in different locations or
 // have a different monotonic clock reading.
 func (t Time) ExactEqual(other Time) bool {
 	return t.Valid == other.Valid && (!t.Valid || t.Time == other.Time)
 }
+
+// appendTimeJSON appends t to b as an RFC 3339 string, the same as time.Time's MarshalJSON.
+func appendTimeJSON(b []byte, t time.Time) ([]byte, error) {
+	out, err := t.AppendText(append(b, '"'))
+	if err != nil {
+		// for the error message of MarshalJSON
+		_, err = t.MarshalJSON()
+		return b, err
+	}
+	return append(out, '"'), nil
+}
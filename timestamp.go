@@ -4,8 +4,9 @@ import (
 	"bytes"
 	"database/sql"
 	"database/sql/driver"
-	"encoding/json"
+	"encoding/xml"
 	"fmt"
+	"strconv"
 	"time"
 )
 
@@ -54,13 +55,35 @@ func (t Timestamp) ValueOrZero() time.Time {
 	return t.Time
 }
 
+// OrElse returns the inner value if valid, otherwise v.
+func (t Timestamp) OrElse(v time.Time) time.Time {
+	if !t.Valid {
+		return v
+	}
+	return t.Time
+}
+
+// OrElseGet returns the inner value if valid, otherwise the result of calling f.
+// f is only called if this Timestamp is null.
+func (t Timestamp) OrElseGet(f func() time.Time) time.Time {
+	if !t.Valid {
+		return f()
+	}
+	return t.Time
+}
+
 // MarshalJSON implements json.Marshaler.
 // It will encode null if this time is null.
 func (t Timestamp) MarshalJSON() ([]byte, error) {
+	return t.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of t to dst, the same as MarshalJSON.
+func (t Timestamp) AppendJSON(dst []byte) ([]byte, error) {
 	if !t.Valid {
-		return []byte("null"), nil
+		return append(dst, nullBytes...), nil
 	}
-	return json.Marshal(t.Time.UnixNano() / int64(time.Millisecond))
+	return strconv.AppendInt(dst, t.Time.UnixNano()/int64(time.Millisecond), 10), nil
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
@@ -71,12 +94,15 @@ func (t *Timestamp) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
-	var value Int64
-	err := value.UnmarshalJSON(data)
-	if err != nil {
-		return err
+	ms, ok := parseJSONInt(data, 64)
+	if !ok {
+		var value Int64
+		if err := value.UnmarshalJSON(data); err != nil {
+			return err
+		}
+		ms = value.Int64
 	}
-	t.Time = time.UnixMilli(0).UTC().Add(time.Duration(value.Int64 * int64(time.Millisecond)))
+	t.Time = time.UnixMilli(0).UTC().Add(time.Duration(ms * int64(time.Millisecond)))
 	t.Valid = true
 	return nil
 }
@@ -84,11 +110,16 @@ func (t *Timestamp) UnmarshalJSON(data []byte) error {
 // MarshalText implements encoding.TextMarshaler.
 // It returns an empty string if invalid, otherwise time.Time's MarshalText.
 func (t Timestamp) MarshalText() ([]byte, error) {
+	return t.AppendText([]byte{})
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends nothing if invalid, otherwise milliseconds since the Unix epoch.
+func (t Timestamp) AppendText(dst []byte) ([]byte, error) {
 	if !t.Valid {
-		return []byte{}, nil
+		return dst, nil
 	}
-	n := Int64From(t.Time.UnixMilli())
-	return n.MarshalText()
+	return strconv.AppendInt(dst, t.Time.UnixMilli(), 10), nil
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
@@ -109,6 +140,96 @@ func (t *Timestamp) UnmarshalText(text []byte) error {
 	return nil
 }
 
+// MarshalXML implements xml.Marshaler.
+// It will encode null as an empty element; see XMLOmit and XMLNil for other encodings.
+func (t Timestamp) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, xmlNullEmpty, !t.Valid, t)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// It will unmarshal to a null Timestamp if the element is empty or has xsi:nil="true".
+func (t *Timestamp) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, t)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will encode null as an empty attribute; see XMLOmit and XMLNil to omit it.
+func (t Timestamp) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, xmlNullEmpty, !t.Valid, t)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+// It will unmarshal to a null Timestamp if the attribute is empty.
+func (t *Timestamp) UnmarshalXMLAttr(attr xml.Attr) error {
+	return t.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It encodes a version byte and a validity byte; valid values are followed by the encoding of time.Time.MarshalBinary, which includes the zone offset.
+func (t Timestamp) MarshalBinary() ([]byte, error) {
+	if !t.Valid {
+		return binaryNull(), nil
+	}
+	data, err := t.Time.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("null: couldn't marshal binary: %w", err)
+	}
+	return append(binaryHeader(len(data)), data...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// It decodes the encoding produced by MarshalBinary.
+func (t *Timestamp) UnmarshalBinary(data []byte) error {
+	value, valid, err := readBinary(data, -1)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		*t = NewTimestamp(time.Time{}, false)
+		return nil
+	}
+	var v time.Time
+	if err := v.UnmarshalBinary(value); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal binary: %w", err)
+	}
+	*t = NewTimestamp(v, true)
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using MarshalBinary.
+func (t Timestamp) GobEncode() ([]byte, error) {
+	return t.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using UnmarshalBinary.
+func (t *Timestamp) GobDecode(data []byte) error {
+	return t.UnmarshalBinary(data)
+}
+
+// MarshalYAML implements yaml.Marshaler from gopkg.in/yaml.v3 and compatible packages.
+// It will encode null if this Timestamp is null.
+func (t Timestamp) MarshalYAML() (any, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	return t.Time.UnixMilli(), nil
+}
+
+// UnmarshalYAML implements the unmarshaler interface of gopkg.in/yaml.v2, which gopkg.in/yaml.v3 also supports.
+// It supports integer millisecond and null input.
+func (t *Timestamp) UnmarshalYAML(unmarshal func(any) error) error {
+	var v *int64
+	if err := unmarshal(&v); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal YAML: %w", err)
+	}
+	if v == nil {
+		*t = NewTimestamp(time.Time{}, false)
+		return nil
+	}
+	*t = TimestampFrom(time.UnixMilli(*v).UTC())
+	return nil
+}
+
 // SetValid changes this Timestamp's value and sets it to be non-null.
 func (t *Timestamp) SetValid(v time.Time) {
 	t.Time = v
@@ -136,6 +257,17 @@ func (t Timestamp) Equal(other Timestamp) bool {
 	return t.Valid == other.Valid && (!t.Valid || t.Time.Equal(other.Time))
 }
 
+// Compare returns an integer comparing two Timestamps, for use with slices.SortFunc and similar.
+// The result is -1 if t < other, 0 if t == other, and +1 if t > other.
+// Times are ordered by the instant they represent, consistent with Equal.
+// Null is considered less than any valid value; use NullsLast for the opposite order.
+func (t Timestamp) Compare(other Timestamp) int {
+	if !t.Valid || !other.Valid {
+		return compareBool(t.Valid, other.Valid)
+	}
+	return t.Time.Compare(other.Time)
+}
+
 // ExactEqual returns true if both Timestamp objects are equal or both null.
 // ExactEqual returns false for times that are in different locations or
 // have a different monotonic clock reading.
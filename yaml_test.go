@@ -0,0 +1,220 @@
+package null
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// unmarshalYAML calls v.UnmarshalYAML with a function decoding the scalar src, which is given in JSON.
+// It only checks how the types handle already decoded values; see yamlDecoded for YAML-specific input.
+func unmarshalYAML(v yamlUnmarshaler, src string) error {
+	return v.UnmarshalYAML(func(out any) error {
+		return json.Unmarshal([]byte(src), out)
+	})
+}
+
+func TestMarshalYAML(t *testing.T) {
+	created := time.Date(2012, 12, 21, 21, 21, 21, 0, time.UTC)
+	tests := []struct {
+		v    interface{ MarshalYAML() (any, error) }
+		want any
+	}{
+		{Int64From(12345), int64(12345)},
+		{Int32From(0), int32(0)},
+		{FloatFrom(1.2345), 1.2345},
+		{BoolFrom(false), false},
+		{LenientBoolFrom(true), true},
+		{StringFrom(""), ""},
+		{TimeFrom(created), created},
+		{TimestampFrom(created), created.UnixMilli()},
+		{NewInt64(1, false), nil},
+		{NewInt32(1, false), nil},
+		{NewFloat(1, false), nil},
+		{NewBool(true, false), nil},
+		{NewString("test", false), nil},
+		{NewTime(created, false), nil},
+		{NewTimestamp(created, false), nil},
+	}
+	for _, test := range tests {
+		got, err := test.v.MarshalYAML()
+		maybePanic(err)
+		if got != test.want {
+			t.Errorf("MarshalYAML(%v): got %#v, want %#v", test.v, got, test.want)
+		}
+	}
+}
+
+func TestUnmarshalYAML(t *testing.T) {
+	var i Int64
+	maybePanic(unmarshalYAML(&i, `12345`))
+	assertInt64(t, i, "yaml int")
+	maybePanic(unmarshalYAML(&i, `null`))
+	assertNullInt64(t, i, "yaml null int")
+
+	var i32 Int32
+	maybePanic(unmarshalYAML(&i32, `0`))
+	if !i32.Valid || i32.Int32 != 0 {
+		t.Errorf("yaml int32 0: got %v, want valid 0", i32)
+	}
+
+	var f Float
+	maybePanic(unmarshalYAML(&f, `1.2345`))
+	assertFloat(t, f, "yaml float")
+
+	var b Bool
+	maybePanic(unmarshalYAML(&b, `true`))
+	assertBool(t, b, "yaml bool")
+	maybePanic(unmarshalYAML(&b, `null`))
+	assertNullBool(t, b, "yaml null bool")
+
+	var s String
+	maybePanic(unmarshalYAML(&s, `""`))
+	if !s.Valid || s.String != "" {
+		t.Errorf("yaml empty string: got %v, want valid \"\"", s)
+	}
+
+	var ti Time
+	maybePanic(unmarshalYAML(&ti, `"2012-12-21T21:21:21Z"`))
+	assertTime(t, ti, "yaml time")
+
+	var ts Timestamp
+	maybePanic(unmarshalYAML(&ts, `1356124881000`))
+	if !ts.Valid || !ts.Time.Equal(time.Date(2012, 12, 21, 21, 21, 21, 0, time.UTC)) {
+		t.Errorf("yaml timestamp: got %v", ts)
+	}
+
+	if err := unmarshalYAML(&i, `"hello"`); err == nil {
+		t.Error("expected error unmarshaling a string into Int64")
+	}
+}
+
+func TestUnmarshalLenientBoolYAML(t *testing.T) {
+	tests := []struct {
+		src   string
+		want  bool
+		valid bool
+	}{
+		{`true`, true, true},
+		{`false`, false, true},
+		{`1`, true, true},
+		{`0`, false, true},
+		{`"yes"`, true, true},
+		{`"off"`, false, true},
+		{`""`, false, false},
+		{`null`, false, false},
+	}
+	for _, test := range tests {
+		b := LenientBoolFrom(true)
+		maybePanic(unmarshalLenientBoolYAML(&b, test.src))
+		if b.Valid != test.valid || (b.Valid && b.Bool.Bool != test.want) {
+			t.Errorf("UnmarshalYAML(%s): got %v, want %v (valid: %v)", test.src, b, test.want, test.valid)
+		}
+	}
+
+	for _, src := range []string{`2`, `1.5`, `"maybe"`, `[]`} {
+		var b LenientBool
+		if err := unmarshalLenientBoolYAML(&b, src); err == nil {
+			t.Errorf("UnmarshalYAML(%s): expected error", src)
+		}
+	}
+}
+
+// unmarshalLenientBoolYAML is like unmarshalYAML, but decodes JSON numbers as int, as YAML decoders do.
+func unmarshalLenientBoolYAML(b *LenientBool, src string) error {
+	return b.UnmarshalYAML(func(out any) error {
+		if n, err := strconv.Atoi(src); err == nil {
+			*out.(*any) = n
+			return nil
+		}
+		return json.Unmarshal([]byte(src), out)
+	})
+}
+
+// yamlDecoded returns an unmarshal function like the one gopkg.in/yaml.v2 and v3 pass to UnmarshalYAML,
+// for a scalar that the decoder has resolved to node: nil for ~ and null, a bool for true (and yes or on in yaml.v2),
+// an int or float64 for numbers, a time.Time for timestamps, and a string for anything else, quoted or not.
+// Like the decoders, it allocates pointers, converts integers to other numeric types if they fit,
+// and returns an error for other mismatches.
+// The types only see these resolved values, so this covers the YAML-specific input without a YAML dependency.
+// sigs.k8s.io/yaml converts YAML to JSON and calls UnmarshalJSON instead, which the JSON tests cover.
+func yamlDecoded(node any) func(any) error {
+	return func(out any) error {
+		return assignYAML(reflect.ValueOf(out).Elem(), node)
+	}
+}
+
+func assignYAML(dst reflect.Value, node any) error {
+	if node == nil {
+		dst.SetZero()
+		return nil
+	}
+	if dst.Kind() == reflect.Pointer {
+		if dst.IsNil() {
+			dst.Set(reflect.New(dst.Type().Elem()))
+		}
+		return assignYAML(dst.Elem(), node)
+	}
+	src := reflect.ValueOf(node)
+	switch {
+	case src.Type().AssignableTo(dst.Type()):
+		dst.Set(src)
+	case src.CanInt() && (dst.CanInt() || dst.CanFloat()):
+		conv := src.Convert(dst.Type())
+		if !conv.Convert(src.Type()).Equal(src) {
+			return fmt.Errorf("yaml: cannot unmarshal %v into %s", node, dst.Type())
+		}
+		dst.Set(conv)
+	default:
+		return fmt.Errorf("yaml: cannot unmarshal %T into %s", node, dst.Type())
+	}
+	return nil
+}
+
+func TestUnmarshalYAMLDecoded(t *testing.T) {
+	created := time.Date(2012, 12, 21, 21, 21, 21, 0, time.UTC)
+	filledInt, filledStr := Int64From(1), StringFrom("test")
+	tests := []struct {
+		src     string // YAML input, for reference
+		node    any    // what the YAML decoder resolves src to
+		v       yamlUnmarshaler
+		want    any
+		wantErr bool
+	}{
+		{"~", nil, &filledInt, NewInt64(0, false), false},
+		{"null", nil, &filledStr, NewString("", false), false},
+		{"12345", 12345, &Int64{}, Int64From(12345), false},
+		{"12345", 12345, &Int32{}, Int32From(12345), false},
+		{"8589934592", 8589934592, &Int32{}, Int32{}, true},
+		{"12345", 12345, &Float{}, FloatFrom(12345), false},
+		{"1.5", 1.5, &Float{}, FloatFrom(1.5), false},
+		{"1.5", 1.5, &Int64{}, Int64{}, true},
+		{`"12345"`, "12345", &Int64{}, Int64{}, true},
+		{"true", true, &Bool{}, BoolFrom(true), false},
+		{"yes (yaml.v2)", true, &Bool{}, BoolFrom(true), false},
+		{"yes (yaml.v3)", "yes", &Bool{}, Bool{}, true},
+		{"yes (yaml.v3)", "yes", &LenientBool{}, LenientBoolFrom(true), false},
+		{"1", 1, &LenientBool{}, LenientBoolFrom(true), false},
+		{"hello", "hello", &String{}, StringFrom("hello"), false},
+		{`""`, "", &String{}, StringFrom(""), false},
+		{"12345", 12345, &String{}, String{}, true},
+		{"2012-12-21T21:21:21Z", created, &Time{}, TimeFrom(created), false},
+		{"1356124881000", 1356124881000, &Timestamp{}, TimestampFrom(created), false},
+	}
+	for _, test := range tests {
+		err := test.v.UnmarshalYAML(yamlDecoded(test.node))
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("UnmarshalYAML(%s) into %T: expected error", test.src, test.v)
+			}
+			continue
+		}
+		maybePanic(err)
+		if got := reflect.ValueOf(test.v).Elem().Interface(); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("UnmarshalYAML(%s) into %T: got %#v, want %#v", test.src, test.v, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package zero
+
+import (
+	"encoding"
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+)
+
+// appender is implemented by every type in this package.
+type appender interface {
+	AppendJSON(dst []byte) ([]byte, error)
+	AppendText(dst []byte) ([]byte, error)
+}
+
+var appendBenchmarks = []struct {
+	name string
+	v    appender
+}{
+	{"Int64", Int64From(1234567890)},
+	{"Int64String", Int64StringFrom(1234567890)},
+	{"Int32", Int32From(123456)},
+	{"Float", FloatFrom(1234.5678)},
+	{"FloatNaNAsNull", FloatNaNAsNullFrom(math.NaN())},
+	{"FloatNaNAsString", FloatNaNAsStringFrom(math.Inf(-1))},
+	{"StrictInt64", StrictInt64From(1234567890)},
+	{"StrictInt32", StrictInt32From(123456)},
+	{"StrictFloat", StrictFloatFrom(1234.5678)},
+	{"Bool", BoolFrom(true)},
+	{"LenientBool", LenientBoolFrom(true)},
+	{"String", StringFrom("hello <world>")},
+	{"Time", TimeFrom(time.Date(2012, 12, 21, 21, 21, 21, 123456789, time.UTC))},
+	{"Timestamp", TimestampFrom(time.Date(2012, 12, 21, 21, 21, 21, 123000000, time.UTC))},
+	{"Null", NewInt64(0, false)},
+}
+
+func BenchmarkAppendJSON(b *testing.B) {
+	for _, bench := range appendBenchmarks {
+		b.Run(bench.name, func(b *testing.B) {
+			b.ReportAllocs()
+			buf := make([]byte, 0, 64)
+			for n := 0; n < b.N; n++ {
+				buf, _ = bench.v.AppendJSON(buf[:0])
+			}
+		})
+	}
+}
+
+func BenchmarkAppendText(b *testing.B) {
+	for _, bench := range appendBenchmarks {
+		b.Run(bench.name, func(b *testing.B) {
+			b.ReportAllocs()
+			buf := make([]byte, 0, 64)
+			for n := 0; n < b.N; n++ {
+				buf, _ = bench.v.AppendText(buf[:0])
+			}
+		})
+	}
+}
+
+func BenchmarkMarshalText(b *testing.B) {
+	for _, bench := range appendBenchmarks {
+		v := bench.v.(encoding.TextMarshaler)
+		b.Run(bench.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for n := 0; n < b.N; n++ {
+				_, _ = v.MarshalText()
+			}
+		})
+	}
+}
+
+func TestAppendAllocs(t *testing.T) {
+	buf := make([]byte, 0, 64)
+	for _, bench := range appendBenchmarks {
+		if allocs := testing.AllocsPerRun(100, func() { buf, _ = bench.v.AppendJSON(buf[:0]) }); allocs > 0 {
+			t.Errorf("%s AppendJSON: %v allocations, want 0", bench.name, allocs)
+		}
+		if allocs := testing.AllocsPerRun(100, func() { buf, _ = bench.v.AppendText(buf[:0]) }); allocs > 0 {
+			t.Errorf("%s AppendText: %v allocations, want 0", bench.name, allocs)
+		}
+	}
+}
+
+var unmarshalBenchmarks = []struct {
+	name  string
+	input []byte
+	v     json.Unmarshaler
+	// std is what encoding/json decodes the input to, for comparison
+	std any
+}{
+	{"Int64", []byte(`1234567890`), new(Int64), new(int64)},
+	{"Int64String", []byte(`"1234567890"`), new(Int64), new(string)},
+	{"Int32", []byte(`123456`), new(Int32), new(int32)},
+	{"Float", []byte(`1234.5678`), new(Float), new(float64)},
+	{"Bool", []byte(`true`), new(Bool), new(bool)},
+	{"LenientBool", []byte(`"yes"`), new(LenientBool), new(string)},
+	{"String", []byte(`"hello world"`), new(String), new(string)},
+	{"EscapedString", []byte(`"hello\n\"world\" é"`), new(String), new(string)},
+	{"Time", []byte(`"2012-12-21T21:21:21.123456789Z"`), new(Time), new(time.Time)},
+	{"Timestamp", []byte(`1356124881000`), new(Timestamp), new(int64)},
+}
+
+func BenchmarkUnmarshalJSON(b *testing.B) {
+	for _, bench := range unmarshalBenchmarks {
+		b.Run(bench.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for n := 0; n < b.N; n++ {
+				_ = bench.v.UnmarshalJSON(bench.input)
+			}
+		})
+	}
+}
+
+func BenchmarkUnmarshalJSONStdlib(b *testing.B) {
+	for _, bench := range unmarshalBenchmarks {
+		b.Run(bench.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for n := 0; n < b.N; n++ {
+				_ = json.Unmarshal(bench.input, bench.std)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package zero
+
+import "fmt"
+
+// binaryVersion is the version of the binary encoding, stored in its first byte.
+// The second byte is 1 if the value is valid, 0 if null, and the encoded value follows if valid.
+const binaryVersion = 1
+
+// binaryNull is the binary encoding of a null value.
+func binaryNull() []byte {
+	return []byte{binaryVersion, 0}
+}
+
+// binaryHeader returns the header of the binary encoding of a valid value,
+// with room for size more bytes.
+func binaryHeader(size int) []byte {
+	return append(make([]byte, 0, 2+size), binaryVersion, 1)
+}
+
+// readBinary checks the header of data and returns the encoded value.
+// If size isn't negative, the value must have exactly size bytes.
+func readBinary(data []byte, size int) (value []byte, valid bool, err error) {
+	if len(data) < 2 {
+		return nil, false, fmt.Errorf("zero: binary data too short: %d bytes", len(data))
+	}
+	if data[0] != binaryVersion {
+		return nil, false, fmt.Errorf("zero: unsupported binary version %d", data[0])
+	}
+	value = data[2:]
+	switch data[1] {
+	case 0:
+		if len(value) != 0 {
+			return nil, false, fmt.Errorf("zero: unexpected %d bytes after null binary value", len(value))
+		}
+		return nil, false, nil
+	case 1:
+		if size >= 0 && len(value) != size {
+			return nil, false, fmt.Errorf("zero: invalid binary value length %d, need %d", len(value), size)
+		}
+		return value, true, nil
+	}
+	return nil, false, fmt.Errorf("zero: invalid binary validity byte %d", data[1])
+}
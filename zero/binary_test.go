@@ -0,0 +1,157 @@
+package zero
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"math"
+	"testing"
+	"time"
+)
+
+type gobRecord struct {
+	ID        Int64
+	Rank      Int32
+	Name      String
+	Score     Float
+	Active    Bool
+	Lenient   LenientBool
+	Created   Time
+	Timestamp Timestamp
+}
+
+func TestMarshalBinary(t *testing.T) {
+	tests := []struct {
+		v    encoding.BinaryMarshaler
+		want []byte
+	}{
+		{Int64From(-2), []byte{1, 1, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xfe}},
+		{NewInt64(5, false), []byte{1, 0}},
+		{Int32From(258), []byte{1, 1, 0, 0, 1, 2}},
+		{FloatFrom(1), []byte{1, 1, 0x3f, 0xf0, 0, 0, 0, 0, 0, 0}},
+		{BoolFrom(true), []byte{1, 1, 1}},
+		{NewBool(false, true), []byte{1, 1, 0}},
+		{StringFrom("hi"), []byte{1, 1, 'h', 'i'}},
+		{NewString("", false), []byte{1, 0}},
+		{NewTime(time.Time{}, false), []byte{1, 0}},
+	}
+	for _, test := range tests {
+		data, err := test.v.MarshalBinary()
+		maybePanic(err)
+		if !bytes.Equal(data, test.want) {
+			t.Errorf("MarshalBinary(%v): got %v, want %v", test.v, data, test.want)
+		}
+	}
+}
+
+func TestUnmarshalBinary(t *testing.T) {
+	loc := time.FixedZone("UTC+3", 3*60*60)
+	created := time.Date(2012, 12, 21, 21, 21, 21, 123456789, loc)
+	tests := []struct {
+		in  encoding.BinaryMarshaler
+		out interface {
+			encoding.BinaryUnmarshaler
+			IsZero() bool
+		}
+		valid bool
+	}{
+		{Int64From(math.MinInt64), new(Int64), true},
+		{Int32From(math.MaxInt32), new(Int32), true},
+		{FloatFrom(math.Inf(-1)), new(Float), true},
+		{BoolFrom(true), new(Bool), true},
+		{StringFrom("a string"), new(String), true},
+		{TimeFrom(created), new(Time), true},
+		{TimestampFrom(created), new(Timestamp), true},
+		{NewInt64(0, false), &Int64{}, false},
+		{NewString("", false), &String{}, false},
+		{NewTime(time.Time{}, false), &Time{}, false},
+	}
+	for _, test := range tests {
+		data, err := test.in.MarshalBinary()
+		maybePanic(err)
+		err = test.out.UnmarshalBinary(data)
+		maybePanic(err)
+		if test.out.IsZero() == test.valid {
+			t.Errorf("UnmarshalBinary(%v): got %v, want valid = %v", data, test.out, test.valid)
+		}
+	}
+
+	var ti Time
+	data, err := TimeFrom(created).MarshalBinary()
+	maybePanic(err)
+	err = ti.UnmarshalBinary(data)
+	maybePanic(err)
+	if !ti.Time.Equal(created) {
+		t.Errorf("time: got %v, want %v", ti.Time, created)
+	}
+	if _, offset := ti.Time.Zone(); offset != 3*60*60 {
+		t.Errorf("time zone offset: got %d, want %d", offset, 3*60*60)
+	}
+}
+
+func TestUnmarshalBinaryError(t *testing.T) {
+	tests := []struct {
+		data []byte
+		v    encoding.BinaryUnmarshaler
+	}{
+		{nil, new(Int64)},
+		{[]byte{1}, new(Int64)},
+		{[]byte{2, 0}, new(Int64)},
+		{[]byte{1, 2}, new(Int64)},
+		{[]byte{1, 0, 0}, new(Int64)},
+		{[]byte{1, 1, 0, 0, 0}, new(Int64)},
+		{[]byte{1, 1, 0, 0, 0, 0, 0}, new(Int32)},
+		{[]byte{1, 1}, new(Float)},
+		{[]byte{1, 1, 2}, new(Bool)},
+		{[]byte{1, 1, 0, 0}, new(Bool)},
+		{[]byte{1, 1, 0}, new(Time)},
+		{[]byte{1, 1}, new(Timestamp)},
+	}
+	for _, test := range tests {
+		if err := test.v.UnmarshalBinary(test.data); err == nil {
+			t.Errorf("UnmarshalBinary(%v) into %T: expected error", test.data, test.v)
+		}
+	}
+}
+
+func TestGob(t *testing.T) {
+	created := time.Date(2012, 12, 21, 21, 21, 21, 0, time.FixedZone("UTC-5", -5*60*60))
+	records := []gobRecord{
+		{
+			ID:        Int64From(12345),
+			Rank:      Int32From(1),
+			Name:      StringFrom("test"),
+			Score:     FloatFrom(1.2345),
+			Active:    BoolFrom(true),
+			Lenient:   LenientBoolFrom(true),
+			Created:   TimeFrom(created),
+			Timestamp: TimestampFrom(created),
+		},
+		{},
+	}
+
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(records)
+	maybePanic(err)
+	var got []gobRecord
+	err = gob.NewDecoder(&buf).Decode(&got)
+	maybePanic(err)
+
+	if len(got) != len(records) {
+		t.Fatalf("gob: got %d records, want %d", len(got), len(records))
+	}
+	for i, rec := range records {
+		g := got[i]
+		if !g.ID.Equal(rec.ID) || !g.Rank.Equal(rec.Rank) || !g.Name.Equal(rec.Name) || !g.Score.Equal(rec.Score) ||
+			!g.Active.Equal(rec.Active) || !g.Lenient.Equal(rec.Lenient.Bool) ||
+			!g.Created.Equal(rec.Created) || !g.Timestamp.Equal(rec.Timestamp) {
+			t.Errorf("gob record %d: got %+v, want %+v", i, g, rec)
+		}
+		// like time.Time.MarshalBinary, the zone offset is kept but not its name
+		_, gotOffset := g.Created.Time.Zone()
+		_, wantOffset := rec.Created.Time.Zone()
+		if gotOffset != wantOffset {
+			t.Errorf("gob record %d: got zone offset %d, want %d", i, gotOffset, wantOffset)
+		}
+	}
+}
@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"database/sql"
 	"encoding/json"
+	"encoding/xml"
 	"errors"
 	"fmt"
+	"strconv"
 )
 
 // Bool is a nullable bool. False input is considered null.
@@ -43,6 +45,23 @@ func (b Bool) ValueOrZero() bool {
 	return b.Valid && b.Bool
 }
 
+// OrElse returns the inner value if valid and non-zero, otherwise v.
+func (b Bool) OrElse(v bool) bool {
+	if b.IsZero() {
+		return v
+	}
+	return b.Bool
+}
+
+// OrElseGet returns the inner value if valid and non-zero, otherwise the result of calling f.
+// f is only called if this Bool is null or zero.
+func (b Bool) OrElseGet(f func() bool) bool {
+	if b.IsZero() {
+		return f()
+	}
+	return b.Bool
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 // "false" will be considered a null Bool.
 func (b *Bool) UnmarshalJSON(data []byte) error {
@@ -51,6 +70,17 @@ func (b *Bool) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
+	switch string(data) {
+	case "true":
+		b.Bool = true
+		b.Valid = true
+		return nil
+	case "false":
+		b.Bool = false
+		b.Valid = false
+		return nil
+	}
+
 	if err := json.Unmarshal(data, &b.Bool); err != nil {
 		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
 	}
@@ -83,19 +113,109 @@ func (b *Bool) UnmarshalText(text []byte) error {
 // MarshalJSON implements json.Marshaler.
 // It will encode null if this Bool is null.
 func (b Bool) MarshalJSON() ([]byte, error) {
-	if !b.Valid || !b.Bool {
-		return []byte("false"), nil
-	}
-	return []byte("true"), nil
+	return b.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of b to dst, the same as MarshalJSON.
+func (b Bool) AppendJSON(dst []byte) ([]byte, error) {
+	return strconv.AppendBool(dst, b.Valid && b.Bool), nil
 }
 
 // MarshalText implements encoding.TextMarshaler.
 // It will encode a zero if this Bool is null.
 func (b Bool) MarshalText() ([]byte, error) {
-	if !b.Valid || !b.Bool {
-		return []byte("false"), nil
+	return b.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append false if this Bool is null.
+func (b Bool) AppendText(dst []byte) ([]byte, error) {
+	return strconv.AppendBool(dst, b.Valid && b.Bool), nil
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode null as the zero value; see XMLEmpty, XMLOmit and XMLNil for other encodings.
+func (b Bool) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, xmlNullZero, b.IsZero(), b)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// It will unmarshal to a null Bool if the element is empty or has xsi:nil="true".
+func (b *Bool) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, b)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will encode null as the zero value; see XMLEmpty and XMLOmit for other encodings.
+func (b Bool) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, xmlNullZero, b.IsZero(), b)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+// It will unmarshal to a null Bool if the attribute is empty.
+func (b *Bool) UnmarshalXMLAttr(attr xml.Attr) error {
+	return b.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It encodes a version byte and a validity byte; valid values are followed by a byte that is 1 for true and 0 for false.
+func (b Bool) MarshalBinary() ([]byte, error) {
+	if !b.Valid {
+		return binaryNull(), nil
+	}
+	if b.Bool {
+		return append(binaryHeader(1), 1), nil
+	}
+	return append(binaryHeader(1), 0), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// It decodes the encoding produced by MarshalBinary.
+func (b *Bool) UnmarshalBinary(data []byte) error {
+	value, valid, err := readBinary(data, 1)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		*b = NewBool(false, false)
+		return nil
 	}
-	return []byte("true"), nil
+	if value[0] > 1 {
+		return fmt.Errorf("zero: invalid binary bool %d", value[0])
+	}
+	*b = NewBool(value[0] == 1, true)
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using MarshalBinary.
+func (b Bool) GobEncode() ([]byte, error) {
+	return b.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using UnmarshalBinary.
+func (b *Bool) GobDecode(data []byte) error {
+	return b.UnmarshalBinary(data)
+}
+
+// MarshalYAML implements yaml.Marshaler from gopkg.in/yaml.v3 and compatible packages.
+// It will encode the zero value if this Bool is null.
+func (b Bool) MarshalYAML() (any, error) {
+	return b.ValueOrZero(), nil
+}
+
+// UnmarshalYAML implements the unmarshaler interface of gopkg.in/yaml.v2, which gopkg.in/yaml.v3 also supports.
+// It supports boolean and null input.
+func (b *Bool) UnmarshalYAML(unmarshal func(any) error) error {
+	var v *bool
+	if err := unmarshal(&v); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal YAML: %w", err)
+	}
+	if v == nil {
+		*b = NewBool(false, false)
+		return nil
+	}
+	*b = BoolFrom(*v)
+	return nil
 }
 
 // SetValid changes this Bool's value and also sets it to be non-null.
@@ -121,3 +241,11 @@ func (b Bool) IsZero() bool {
 func (b Bool) Equal(other Bool) bool {
 	return b.ValueOrZero() == other.ValueOrZero()
 }
+
+// Compare returns an integer comparing two Bools, for use with slices.SortFunc and similar.
+// The result is -1 if b < other, 0 if b == other, and +1 if b > other.
+// Null is considered equal to the zero value, consistent with Equal.
+// false is considered less than true.
+func (b Bool) Compare(other Bool) int {
+	return compareBool(b.ValueOrZero(), other.ValueOrZero())
+}
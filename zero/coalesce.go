@@ -0,0 +1,42 @@
+package zero
+
+import (
+	"time"
+)
+
+// Coalesce returns the first value that is neither null nor zero, like SQL's COALESCE.
+// It returns null if all values are null or zero, or no values are given.
+func Coalesce[T interface{ IsZero() bool }](vals ...T) T {
+	for _, v := range vals {
+		if !v.IsZero() {
+			return v
+		}
+	}
+	var null T
+	return null
+}
+
+// NullIf returns null if v is equal to sentinel, otherwise v, like SQL's NULLIF.
+// Null or zero values are returned unchanged.
+// Times are compared with time.Time's Equal method, so they match regardless of location.
+func NullIf[T interface {
+	ValueOrZero() V
+	IsZero() bool
+}, V comparable](v T, sentinel V) T {
+	if v.IsZero() {
+		return v
+	}
+	if equalValue(v.ValueOrZero(), sentinel) {
+		var null T
+		return null
+	}
+	return v
+}
+
+// equalValue reports whether a and b are equal, comparing times by instant.
+func equalValue[V comparable](a, b V) bool {
+	if t, ok := any(a).(time.Time); ok {
+		return t.Equal(any(b).(time.Time))
+	}
+	return a == b
+}
@@ -0,0 +1,75 @@
+package zero
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCoalesce(t *testing.T) {
+	i := Coalesce(NewInt64(1, false), NewInt64(0, true), Int64From(2))
+	assertInt64EqualIsTrue(t, i, Int64From(2))
+
+	s := Coalesce(NewString("a", false), StringFrom(""), StringFrom("b"))
+	assertStringEqualIsTrue(t, s, StringFrom("b"))
+
+	null := Coalesce(NewFloat(1, false), NewFloat(0, true))
+	assertNullFloat(t, null, "Coalesce() of nulls and zeros")
+
+	none := Coalesce[Bool]()
+	assertNullBool(t, none, "Coalesce() of nothing")
+}
+
+func TestNullIf(t *testing.T) {
+	assertNullInt64(t, NullIf(Int64From(-1), -1), "NullIf() sentinel")
+	assertInt64EqualIsTrue(t, NullIf(Int64From(5), -1), Int64From(5))
+	assertNullStr(t, NullIf(StringFrom("N/A"), "N/A"), "NullIf() string sentinel")
+
+	loc := time.FixedZone("UTC+2", 2*60*60)
+	assertNullTime(t, NullIf(TimeFrom(timeValue1.In(loc)), timeValue1), "NullIf() time in other location")
+	assertTimestamp(t, NullIf(TimestampFrom(timeValue1), timeValue3), "NullIf() different time")
+}
+
+func TestOrElse(t *testing.T) {
+	if v := NewInt64(1, false).OrElse(42); v != 42 {
+		t.Errorf("OrElse() = %d, want 42", v)
+	}
+	if v := NewInt64(0, true).OrElse(42); v != 42 {
+		t.Errorf("OrElse() = %d, want 42", v)
+	}
+	if v := Int32From(7).OrElse(42); v != 7 {
+		t.Errorf("OrElse() = %d, want 7", v)
+	}
+	if v := NewFloat(0, true).OrElse(1.5); v != 1.5 {
+		t.Errorf("OrElse() = %v, want 1.5", v)
+	}
+	if v := NewBool(false, true).OrElse(true); v != true {
+		t.Errorf("OrElse() = %v, want true", v)
+	}
+	if v := NewString("", true).OrElse("default"); v != "default" {
+		t.Errorf("OrElse() = %q, want default", v)
+	}
+	if v := NewTime(time.Time{}, true).OrElse(timeValue1); !v.Equal(timeValue1) {
+		t.Errorf("OrElse() = %v, want %v", v, timeValue1)
+	}
+	if v := TimestampFrom(timeValue1).OrElse(timeValue3); !v.Equal(timeValue1) {
+		t.Errorf("OrElse() = %v, want %v", v, timeValue1)
+	}
+}
+
+func TestOrElseGet(t *testing.T) {
+	called := false
+	get := func() int64 {
+		called = true
+		return 42
+	}
+
+	if v := Int64From(7).OrElseGet(get); v != 7 || called {
+		t.Errorf("OrElseGet() = %d (called: %t), want 7 without calling", v, called)
+	}
+	if v := NewInt64(0, true).OrElseGet(get); v != 42 || !called {
+		t.Errorf("OrElseGet() = %d (called: %t), want 42", v, called)
+	}
+	if v := NewString("", false).OrElseGet(func() string { return "x" }); v != "x" {
+		t.Errorf("OrElseGet() = %q, want x", v)
+	}
+}
@@ -0,0 +1,67 @@
+package zero
+
+// Map applies f to the inner value of in and returns the result as the nullable type Out,
+// or null if in is null or zero. Out is usually given explicitly and everything else is inferred:
+//
+//	price := zero.Map[zero.String](cents, formatCents)
+func Map[Out any, POut interface {
+	*Out
+	SetValid(R)
+}, In interface {
+	ValueOrZero() V
+	IsZero() bool
+}, V, R any](in In, f func(V) R) Out {
+	var out Out
+	if in.IsZero() {
+		return out
+	}
+	POut(&out).SetValid(f(in.ValueOrZero()))
+	return out
+}
+
+// FlatMap applies f to the inner value of in and returns its result,
+// or null if in is null or zero. Unlike Map, f itself may return null.
+func FlatMap[In interface {
+	ValueOrZero() V
+	IsZero() bool
+}, V, Out any](in In, f func(V) Out) Out {
+	if in.IsZero() {
+		var null Out
+		return null
+	}
+	return f(in.ValueOrZero())
+}
+
+// Filter returns v if it is valid, non-zero, and keep reports true for its inner value, otherwise null.
+func Filter[T interface {
+	ValueOrZero() V
+	IsZero() bool
+}, V any](v T, keep func(V) bool) T {
+	if v.IsZero() || !keep(v.ValueOrZero()) {
+		var null T
+		return null
+	}
+	return v
+}
+
+// Zip applies f to the inner values of a and b and returns the result as the nullable type Out,
+// or null if either a or b is null or zero. Like Map, Out is usually given explicitly:
+//
+//	total := zero.Zip[zero.Float](price, quantity, func(p float64, q int64) float64 { return p * float64(q) })
+func Zip[Out any, POut interface {
+	*Out
+	SetValid(R)
+}, A interface {
+	ValueOrZero() VA
+	IsZero() bool
+}, B interface {
+	ValueOrZero() VB
+	IsZero() bool
+}, VA, VB, R any](a A, b B, f func(VA, VB) R) Out {
+	var out Out
+	if a.IsZero() || b.IsZero() {
+		return out
+	}
+	POut(&out).SetValid(f(a.ValueOrZero(), b.ValueOrZero()))
+	return out
+}
@@ -0,0 +1,45 @@
+package zero
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestMap(t *testing.T) {
+	str := Map[String](Int64From(12345), func(i int64) string { return strconv.FormatInt(i, 10) })
+	assertStringEqualIsTrue(t, str, StringFrom("12345"))
+
+	null := Map[String](NewInt64(12345, false), func(i int64) string { return strconv.FormatInt(i, 10) })
+	assertNullStr(t, null, "Map() null")
+
+	zero := Map[String](NewInt64(0, true), func(i int64) string { return strconv.FormatInt(i, 10) })
+	assertNullStr(t, zero, "Map() zero")
+}
+
+func TestFlatMap(t *testing.T) {
+	parse := func(s string) Int64 {
+		var i Int64
+		if err := i.UnmarshalText([]byte(s)); err != nil {
+			return NewInt64(0, false)
+		}
+		return i
+	}
+
+	assertInt64(t, FlatMap(StringFrom("12345"), parse), "FlatMap()")
+	assertNullInt64(t, FlatMap(StringFrom(""), parse), "FlatMap() blank")
+}
+
+func TestFilter(t *testing.T) {
+	even := func(i int64) bool { return i%2 == 0 }
+	assertNullInt64(t, Filter(Int64From(12345), even), "Filter() rejected")
+	assertNullInt64(t, Filter(NewInt64(0, true), even), "Filter() zero")
+	assertInt64EqualIsTrue(t, Filter(Int64From(2), even), Int64From(2))
+}
+
+func TestZip(t *testing.T) {
+	concat := func(a string, b int64) string { return a + strconv.FormatInt(b, 10) }
+
+	assertStringEqualIsTrue(t, Zip[String](StringFrom("test"), Int64From(1), concat), StringFrom("test1"))
+	assertNullStr(t, Zip[String](StringFrom(""), Int64From(1), concat), "Zip() blank")
+	assertNullStr(t, Zip[String](StringFrom("test"), NewInt64(1, false), concat), "Zip() null")
+}
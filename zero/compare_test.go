@@ -0,0 +1,50 @@
+package zero
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name string
+		got  int
+		want int
+	}{
+		{"int64 less", Int64From(1).Compare(Int64From(2)), -1},
+		{"int64 null equals zero", NewInt64(5, false).Compare(NewInt64(0, true)), 0},
+		{"int64 null greater than negative", NewInt64(5, false).Compare(Int64From(-5)), 1},
+		{"int32 greater", Int32From(3).Compare(Int32From(2)), 1},
+		{"float less", FloatFrom(1.5).Compare(FloatFrom(2)), -1},
+		{"bool null equals false", NewBool(true, false).Compare(NewBool(false, true)), 0},
+		{"bool less", BoolFrom(false).Compare(BoolFrom(true)), -1},
+		{"string null equals blank", NewString("a", false).Compare(StringFrom("")), 0},
+		{"string less", StringFrom("a").Compare(StringFrom("b")), -1},
+		{"time location", TimeFrom(timeValue1).Compare(TimeFrom(timeValue2)), 0},
+		{"time null equals zero", NewTime(timeValue1, false).Compare(NewTime(time.Time{}, true)), 0},
+		{"timestamp less", TimestampFrom(timeValue1).Compare(TimestampFrom(timeValue3)), -1},
+	}
+	for _, test := range tests {
+		if test.got != test.want {
+			t.Errorf("%s: Compare() = %d, want %d", test.name, test.got, test.want)
+		}
+	}
+}
+
+func TestNullsFirstLast(t *testing.T) {
+	vals := []Int64{Int64From(3), NewInt64(0, false), Int64From(-1), NewInt64(0, true), Int64From(2)}
+
+	slices.SortFunc(vals, NullsFirst)
+	assertInt64Order(t, vals, "NULLS FIRST", Int64From(0), Int64From(0), Int64From(-1), Int64From(2), Int64From(3))
+
+	slices.SortFunc(vals, NullsLast)
+	assertInt64Order(t, vals, "NULLS LAST", Int64From(-1), Int64From(2), Int64From(3), Int64From(0), Int64From(0))
+}
+
+func assertInt64Order(t *testing.T, got []Int64, from string, want ...Int64) {
+	t.Helper()
+	if !slices.EqualFunc(got, want, Int64.Equal) {
+		t.Errorf("bad %s order: %v ≠ %v", from, got, want)
+	}
+}
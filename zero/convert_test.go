@@ -0,0 +1,114 @@
+package zero
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestInt32Conversions(t *testing.T) {
+	assertInt64EqualIsTrue(t, Int32From(-5).ToInt64(), Int64From(-5))
+	assertNullInt64(t, NewInt32(5, false).ToInt64(), "ToInt64() null")
+	assertFloatEqualIsTrue(t, Int32From(math.MaxInt32).ToFloat(), FloatFrom(math.MaxInt32))
+	assertNullFloat(t, NewInt32(5, false).ToFloat(), "ToFloat() null")
+}
+
+func TestInt64ToInt32(t *testing.T) {
+	i, err := Int64From(math.MinInt32).ToInt32()
+	maybePanic(err)
+	assertInt32EqualIsTrue(t, i, Int32From(math.MinInt32))
+
+	i, err = NewInt64(math.MaxInt64, false).ToInt32()
+	maybePanic(err)
+	assertNullInt32(t, i, "ToInt32() null")
+
+	for _, n := range []int64{math.MaxInt32 + 1, math.MinInt32 - 1, math.MaxInt64} {
+		i, err = Int64From(n).ToInt32()
+		if !errors.Is(err, ErrOverflow) {
+			t.Errorf("ToInt32() of %d: expected ErrOverflow, got %v", n, err)
+		}
+		assertNullInt32(t, i, "ToInt32() overflow")
+	}
+}
+
+func TestInt64ToFloat(t *testing.T) {
+	f, err := Int64From(1 << 53).ToFloat()
+	maybePanic(err)
+	assertFloatEqualIsTrue(t, f, FloatFrom(1<<53))
+
+	f, err = Int64From(math.MinInt64).ToFloat()
+	maybePanic(err)
+	assertFloatEqualIsTrue(t, f, FloatFrom(math.MinInt64))
+
+	f, err = NewInt64(1, false).ToFloat()
+	maybePanic(err)
+	assertNullFloat(t, f, "ToFloat() null")
+
+	for _, n := range []int64{1<<53 + 1, math.MaxInt64, -(1<<60 + 1)} {
+		f, err = Int64From(n).ToFloat()
+		if !errors.Is(err, ErrPrecisionLoss) {
+			t.Errorf("ToFloat() of %d: expected ErrPrecisionLoss, got %v", n, err)
+		}
+		assertNullFloat(t, f, "ToFloat() precision loss")
+	}
+}
+
+func TestFloatToInt(t *testing.T) {
+	tests := []struct {
+		in   float64
+		mode RoundingMode
+		want int64
+		err  error
+	}{
+		{2, RoundExact, 2, nil},
+		{2.5, RoundExact, 0, ErrPrecisionLoss},
+		{2.5, RoundTruncate, 2, nil},
+		{-2.5, RoundTruncate, -2, nil},
+		{2.5, RoundNearest, 3, nil},
+		{-2.5, RoundNearest, -3, nil},
+		{2.5, RoundNearestEven, 2, nil},
+		{3.5, RoundNearestEven, 4, nil},
+		{-2.5, RoundFloor, -3, nil},
+		{-2.5, RoundCeil, -2, nil},
+		{math.NaN(), RoundTruncate, 0, ErrOverflow},
+		{math.Inf(1), RoundTruncate, 0, ErrOverflow},
+		{1 << 63, RoundExact, 0, ErrOverflow},
+		{-1 << 63, RoundExact, math.MinInt64, nil},
+	}
+	for _, test := range tests {
+		got, err := FloatFrom(test.in).ToInt64(test.mode)
+		if !errors.Is(err, test.err) {
+			t.Errorf("ToInt64(%v, %d): unexpected error %v, want %v", test.in, test.mode, err, test.err)
+		}
+		if test.err == nil && !got.Equal(Int64From(test.want)) {
+			t.Errorf("ToInt64(%v, %d) = %v, want %d", test.in, test.mode, got, test.want)
+		}
+	}
+
+	i, err := NewFloat(1.5, false).ToInt64(RoundExact)
+	maybePanic(err)
+	assertNullInt64(t, i, "ToInt64() null")
+
+	i32, err := FloatFrom(math.MaxInt32 + 0.4).ToInt32(RoundNearest)
+	maybePanic(err)
+	assertInt32EqualIsTrue(t, i32, Int32From(math.MaxInt32))
+
+	_, err = FloatFrom(math.MaxInt32 + 0.5).ToInt32(RoundNearest)
+	if !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected ErrOverflow, got %v", err)
+	}
+}
+
+func TestConversionsOfZero(t *testing.T) {
+	i, err := NewInt64(0, true).ToInt32()
+	maybePanic(err)
+	assertNullInt32(t, i, "ToInt32() zero")
+
+	f, err := NewInt64(0, true).ToFloat()
+	maybePanic(err)
+	assertNullFloat(t, f, "ToFloat() zero")
+
+	n, err := FloatFrom(-0.4).ToInt64(RoundNearest)
+	maybePanic(err)
+	assertNullInt64(t, n, "ToInt64() rounded to zero")
+}
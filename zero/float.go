@@ -2,8 +2,11 @@ package zero
 
 import (
 	"bytes"
+	"cmp"
 	"database/sql"
+	"encoding/binary"
 	"encoding/json"
+	"encoding/xml"
 	"errors"
 	"fmt"
 	"math"
@@ -49,6 +52,23 @@ func (f Float) ValueOrZero() float64 {
 	return f.Float64
 }
 
+// OrElse returns the inner value if valid and non-zero, otherwise v.
+func (f Float) OrElse(v float64) float64 {
+	if f.IsZero() {
+		return v
+	}
+	return f.Float64
+}
+
+// OrElseGet returns the inner value if valid and non-zero, otherwise the result of calling fn.
+// fn is only called if this Float is null or zero.
+func (f Float) OrElseGet(fn func() float64) float64 {
+	if f.IsZero() {
+		return fn()
+	}
+	return f.Float64
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 // It supports number and null input.
 // 0 will be considered a null Float.
@@ -58,6 +78,12 @@ func (f *Float) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
+	if n, ok := parseJSONFloat(data); ok {
+		f.Float64 = n
+		f.Valid = n != 0
+		return nil
+	}
+
 	if err := json.Unmarshal(data, &f.Float64); err != nil {
 		var typeError *json.UnmarshalTypeError
 		if errors.As(err, &typeError) {
@@ -103,29 +129,111 @@ func (f *Float) UnmarshalText(text []byte) error {
 }
 
 // MarshalJSON implements json.Marshaler.
-// It will encode null if this Float is null.
+// It will encode 0 if this Float is null, and return an error for NaN and infinity;
+// see FloatNaNAsNull and FloatNaNAsString to encode them instead.
 func (f Float) MarshalJSON() ([]byte, error) {
-	n := f.Float64
-	if !f.Valid {
-		n = 0
-	}
-	if math.IsInf(f.Float64, 0) || math.IsNaN(f.Float64) {
-		return nil, &json.UnsupportedValueError{
-			Value: reflect.ValueOf(f.Float64),
-			Str:   strconv.FormatFloat(f.Float64, 'g', -1, 64),
-		}
-	}
-	return []byte(strconv.FormatFloat(n, 'f', -1, 64)), nil
+	return f.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of f to dst, the same as MarshalJSON.
+// It returns an error for NaN and infinity.
+func (f Float) AppendJSON(dst []byte) ([]byte, error) {
+	return f.AppendText(dst)
 }
 
 // MarshalText implements encoding.TextMarshaler.
-// It will encode a zero if this Float is null.
+// It will encode a zero if this Float is null, and return an error for NaN and infinity, like MarshalJSON;
+// see FloatNaNAsNull and FloatNaNAsString to encode them instead.
 func (f Float) MarshalText() ([]byte, error) {
-	n := f.Float64
+	return f.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append a zero if this Float is null, and return an error for NaN and infinity.
+func (f Float) AppendText(dst []byte) ([]byte, error) {
+	if f.Valid && isNonFinite(f.Float64) {
+		return dst, unsupportedFloatError(f.Float64)
+	}
+	return strconv.AppendFloat(dst, f.ValueOrZero(), 'f', -1, 64), nil
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode null as the zero value; see XMLEmpty, XMLOmit and XMLNil for other encodings.
+func (f Float) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, xmlNullZero, f.IsZero(), f)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// It will unmarshal to a null Float if the element is empty or has xsi:nil="true".
+func (f *Float) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, f)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will encode null as the zero value; see XMLEmpty and XMLOmit for other encodings.
+func (f Float) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, xmlNullZero, f.IsZero(), f)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+// It will unmarshal to a null Float if the attribute is empty.
+func (f *Float) UnmarshalXMLAttr(attr xml.Attr) error {
+	return f.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It encodes a version byte and a validity byte; valid values are followed by the IEEE 754 bits of the value as 8 big-endian bytes.
+func (f Float) MarshalBinary() ([]byte, error) {
 	if !f.Valid {
-		n = 0
+		return binaryNull(), nil
 	}
-	return []byte(strconv.FormatFloat(n, 'f', -1, 64)), nil
+	return binary.BigEndian.AppendUint64(binaryHeader(8), math.Float64bits(f.Float64)), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// It decodes the encoding produced by MarshalBinary.
+func (f *Float) UnmarshalBinary(data []byte) error {
+	value, valid, err := readBinary(data, 8)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		*f = NewFloat(0, false)
+		return nil
+	}
+	*f = NewFloat(math.Float64frombits(binary.BigEndian.Uint64(value)), true)
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using MarshalBinary.
+func (f Float) GobEncode() ([]byte, error) {
+	return f.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using UnmarshalBinary.
+func (f *Float) GobDecode(data []byte) error {
+	return f.UnmarshalBinary(data)
+}
+
+// MarshalYAML implements yaml.Marshaler from gopkg.in/yaml.v3 and compatible packages.
+// It will encode the zero value if this Float is null.
+func (f Float) MarshalYAML() (any, error) {
+	return f.ValueOrZero(), nil
+}
+
+// UnmarshalYAML implements the unmarshaler interface of gopkg.in/yaml.v2, which gopkg.in/yaml.v3 also supports.
+// It supports number and null input.
+func (f *Float) UnmarshalYAML(unmarshal func(any) error) error {
+	var v *float64
+	if err := unmarshal(&v); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal YAML: %w", err)
+	}
+	if v == nil {
+		*f = NewFloat(0, false)
+		return nil
+	}
+	*f = FloatFrom(*v)
+	return nil
 }
 
 // SetValid changes this Float's value and also sets it to be non-null.
@@ -155,3 +263,34 @@ func (f Float) IsZero() bool {
 func (f Float) Equal(other Float) bool {
 	return f.ValueOrZero() == other.ValueOrZero()
 }
+
+// Compare returns an integer comparing two Floats, for use with slices.SortFunc and similar.
+// The result is -1 if f < other, 0 if f == other, and +1 if f > other.
+// Null is considered equal to the zero value, consistent with Equal.
+// NaN is considered less than any other value, like cmp.Compare.
+func (f Float) Compare(other Float) int {
+	return cmp.Compare(f.ValueOrZero(), other.ValueOrZero())
+}
+
+func isNonFinite(f float64) bool {
+	return math.IsInf(f, 0) || math.IsNaN(f)
+}
+
+// unsupportedFloatError is the error for encoding NaN and infinity to JSON or text, the same as encoding/json's.
+func unsupportedFloatError(f float64) error {
+	return &json.UnsupportedValueError{
+		Value: reflect.ValueOf(f),
+		Str:   strconv.FormatFloat(f, 'g', -1, 64),
+	}
+}
+
+// appendNonFinite appends NaN, Infinity or -Infinity.
+func appendNonFinite(b []byte, f float64) []byte {
+	switch {
+	case math.IsNaN(f):
+		return append(b, "NaN"...)
+	case f > 0:
+		return append(b, "Infinity"...)
+	}
+	return append(b, "-Infinity"...)
+}
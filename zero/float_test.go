@@ -271,3 +271,22 @@ func assertFloatEqualIsFalse(t *testing.T, a, b Float) {
 		t.Errorf("Equal() of Float{%v, Valid:%t} and Float{%v, Valid:%t} should return false", a.Float64, a.Valid, b.Float64, b.Valid)
 	}
 }
+
+func TestFloatNonFinite(t *testing.T) {
+	// JSON and text encoding agree
+	for _, f := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		var unsupported *json.UnsupportedValueError
+		if _, err := json.Marshal(FloatFrom(f)); !errors.As(err, &unsupported) {
+			t.Errorf("json.Marshal(%v): expected *json.UnsupportedValueError, got %v", f, err)
+		}
+		if _, err := FloatFrom(f).MarshalText(); !errors.As(err, &unsupported) {
+			t.Errorf("MarshalText(%v): expected *json.UnsupportedValueError, got %v", f, err)
+		}
+		if data, err := FloatFrom(f).AppendText([]byte("prefix")); !errors.As(err, &unsupported) || string(data) != "prefix" {
+			t.Errorf("AppendText(%v): got %q, %v, want unchanged prefix and *json.UnsupportedValueError", f, data, err)
+		}
+	}
+	data, err := NewFloat(math.NaN(), false).MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "0", "null NaN text marshal")
+}
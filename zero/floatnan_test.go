@@ -0,0 +1,111 @@
+package zero
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestFloatNaNAsNull(t *testing.T) {
+	tests := []struct {
+		f    FloatNaNAsNull
+		json string
+		text string
+	}{
+		{FloatNaNAsNullFrom(math.NaN()), `0`, `0`},
+		{FloatNaNAsNullFrom(math.Inf(1)), `0`, `0`},
+		{FloatNaNAsNullFrom(math.Inf(-1)), `0`, `0`},
+		{FloatNaNAsNullFrom(1.5), `1.5`, `1.5`},
+		{NewFloatNaNAsNull(1.5, false), `0`, `0`},
+	}
+	for _, test := range tests {
+		data, err := json.Marshal(test.f)
+		maybePanic(err)
+		assertJSONEquals(t, data, test.json, fmt.Sprintf("json marshal %v", test.f.Float64))
+		data, err = test.f.MarshalText()
+		maybePanic(err)
+		assertJSONEquals(t, data, test.text, fmt.Sprintf("text marshal %v", test.f.Float64))
+	}
+}
+
+func TestFloatNaNAsString(t *testing.T) {
+	tests := []struct {
+		f    FloatNaNAsString
+		json string
+		text string
+	}{
+		{FloatNaNAsStringFrom(math.NaN()), `"NaN"`, `NaN`},
+		{FloatNaNAsStringFrom(math.Inf(1)), `"Infinity"`, `Infinity`},
+		{FloatNaNAsStringFrom(math.Inf(-1)), `"-Infinity"`, `-Infinity`},
+		{FloatNaNAsStringFrom(1.5), `1.5`, `1.5`},
+		{NewFloatNaNAsString(1.5, false), `0`, `0`},
+	}
+	for _, test := range tests {
+		data, err := json.Marshal(test.f)
+		maybePanic(err)
+		assertJSONEquals(t, data, test.json, fmt.Sprintf("json marshal %v", test.f.Float64))
+		data, err = test.f.MarshalText()
+		maybePanic(err)
+		assertJSONEquals(t, data, test.text, fmt.Sprintf("text marshal %v", test.f.Float64))
+	}
+}
+
+func TestUnmarshalFloatNaNAsString(t *testing.T) {
+	for _, input := range []string{`"NaN"`, `"Infinity"`, `"-Infinity"`} {
+		var f FloatNaNAsString
+		err := json.Unmarshal([]byte(input), &f)
+		maybePanic(err)
+		if !f.Valid || !isNonFinite(f.Float64) {
+			t.Errorf("json %s: got %v, want valid non-finite float", input, f.Float64)
+		}
+		data, err := json.Marshal(f)
+		maybePanic(err)
+		assertJSONEquals(t, data, input, "json round trip")
+
+		var text FloatNaNAsString
+		err = text.UnmarshalText(data[1 : len(data)-1])
+		maybePanic(err)
+		data, err = text.MarshalText()
+		maybePanic(err)
+		assertJSONEquals(t, data, input[1:len(input)-1], "text round trip")
+	}
+}
+
+func TestFloatNaNStruct(t *testing.T) {
+	// each field uses its own encoding
+	type record struct {
+		XMLName xml.Name         `json:"-" xml:"record"`
+		Score   FloatNaNAsNull   `json:"score" xml:"score"`
+		Ratio   FloatNaNAsString `json:"ratio" xml:"ratio,attr"`
+		Value   Float            `json:"value" xml:"value"`
+	}
+	rec := record{
+		Score: FloatNaNAsNullFrom(math.NaN()),
+		Ratio: FloatNaNAsStringFrom(math.Inf(1)),
+		Value: FloatFrom(1.5),
+	}
+	data, err := json.Marshal(rec)
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"score":0,"ratio":"Infinity","value":1.5}`, "struct json marshal")
+	data, err = xml.Marshal(rec)
+	maybePanic(err)
+	assertJSONEquals(t, data, `<record ratio="Infinity"><score>0</score><value>1.5</value></record>`, "struct xml marshal")
+
+	rec.Value = FloatFrom(math.NaN())
+	if _, err := json.Marshal(rec); err == nil {
+		t.Error("json.Marshal() of NaN Float: expected error")
+	}
+}
+
+func TestFloatNaNEqualCompare(t *testing.T) {
+	a, b := FloatNaNAsStringFrom(1), FloatNaNAsStringFrom(2)
+	if !a.Equal(FloatNaNAsStringFrom(1)) || a.Equal(b) || a.Compare(b) != -1 || b.Compare(a) != 1 {
+		t.Error("FloatNaNAsString comparison returned the wrong result")
+	}
+	c, d := FloatNaNAsNullFrom(1), FloatNaNAsNullFrom(2)
+	if !c.Equal(FloatNaNAsNullFrom(1)) || c.Equal(d) || c.Compare(d) != -1 || d.Compare(c) != 1 {
+		t.Error("FloatNaNAsNull comparison returned the wrong result")
+	}
+}
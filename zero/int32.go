@@ -2,8 +2,11 @@ package zero
 
 import (
 	"bytes"
+	"cmp"
 	"database/sql"
+	"encoding/binary"
 	"encoding/json"
+	"encoding/xml"
 	"errors"
 	"fmt"
 	"strconv"
@@ -48,6 +51,23 @@ func (i Int32) ValueOrZero() int32 {
 	return i.Int32
 }
 
+// OrElse returns the inner value if valid and non-zero, otherwise v.
+func (i Int32) OrElse(v int32) int32 {
+	if i.IsZero() {
+		return v
+	}
+	return i.Int32
+}
+
+// OrElseGet returns the inner value if valid and non-zero, otherwise the result of calling f.
+// f is only called if this Int32 is null or zero.
+func (i Int32) OrElseGet(f func() int32) int32 {
+	if i.IsZero() {
+		return f()
+	}
+	return i.Int32
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 // It supports number and null input.
 // 0 will be considered a null Int32.
@@ -57,6 +77,12 @@ func (i *Int32) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
+	if n, ok := parseJSONInt(data, 32); ok {
+		i.Int32 = int32(n)
+		i.Valid = n != 0
+		return nil
+	}
+
 	if err := json.Unmarshal(data, &i.Int32); err != nil {
 		var typeError *json.UnmarshalTypeError
 		if errors.As(err, &typeError) {
@@ -104,21 +130,111 @@ func (i *Int32) UnmarshalText(text []byte) error {
 // MarshalJSON implements json.Marshaler.
 // It will encode 0 if this Int32 is null.
 func (i Int32) MarshalJSON() ([]byte, error) {
+	return i.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of i to dst, the same as MarshalJSON.
+func (i Int32) AppendJSON(dst []byte) ([]byte, error) {
 	n := i.Int32
 	if !i.Valid {
 		n = 0
 	}
-	return []byte(strconv.FormatInt(int64(n), 10)), nil
+	return strconv.AppendInt(dst, int64(n), 10), nil
 }
 
 // MarshalText implements encoding.TextMarshaler.
 // It will encode a zero if this Int32 is null.
 func (i Int32) MarshalText() ([]byte, error) {
+	return i.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append a zero if this Int32 is null.
+func (i Int32) AppendText(dst []byte) ([]byte, error) {
 	n := i.Int32
 	if !i.Valid {
 		n = 0
 	}
-	return []byte(strconv.FormatInt(int64(n), 10)), nil
+	return strconv.AppendInt(dst, int64(n), 10), nil
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode null as the zero value; see XMLEmpty, XMLOmit and XMLNil for other encodings.
+func (i Int32) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, xmlNullZero, i.IsZero(), i)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// It will unmarshal to a null Int32 if the element is empty or has xsi:nil="true".
+func (i *Int32) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, i)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will encode null as the zero value; see XMLEmpty and XMLOmit for other encodings.
+func (i Int32) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, xmlNullZero, i.IsZero(), i)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+// It will unmarshal to a null Int32 if the attribute is empty.
+func (i *Int32) UnmarshalXMLAttr(attr xml.Attr) error {
+	return i.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It encodes a version byte and a validity byte; valid values are followed by the value as 4 big-endian bytes.
+func (i Int32) MarshalBinary() ([]byte, error) {
+	if !i.Valid {
+		return binaryNull(), nil
+	}
+	return binary.BigEndian.AppendUint32(binaryHeader(4), uint32(i.Int32)), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// It decodes the encoding produced by MarshalBinary.
+func (i *Int32) UnmarshalBinary(data []byte) error {
+	value, valid, err := readBinary(data, 4)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		*i = NewInt32(0, false)
+		return nil
+	}
+	*i = NewInt32(int32(binary.BigEndian.Uint32(value)), true)
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using MarshalBinary.
+func (i Int32) GobEncode() ([]byte, error) {
+	return i.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using UnmarshalBinary.
+func (i *Int32) GobDecode(data []byte) error {
+	return i.UnmarshalBinary(data)
+}
+
+// MarshalYAML implements yaml.Marshaler from gopkg.in/yaml.v3 and compatible packages.
+// It will encode the zero value if this Int32 is null.
+func (i Int32) MarshalYAML() (any, error) {
+	return i.ValueOrZero(), nil
+}
+
+// UnmarshalYAML implements the unmarshaler interface of gopkg.in/yaml.v2, which gopkg.in/yaml.v3 also supports.
+// It supports integer and null input.
+func (i *Int32) UnmarshalYAML(unmarshal func(any) error) error {
+	var v *int32
+	if err := unmarshal(&v); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal YAML: %w", err)
+	}
+	if v == nil {
+		*i = NewInt32(0, false)
+		return nil
+	}
+	*i = Int32From(*v)
+	return nil
 }
 
 // SetValid changes this Int32's value and also sets it to be non-null.
@@ -144,3 +260,10 @@ func (i Int32) IsZero() bool {
 func (i Int32) Equal(other Int32) bool {
 	return i.ValueOrZero() == other.ValueOrZero()
 }
+
+// Compare returns an integer comparing two Int32s, for use with slices.SortFunc and similar.
+// The result is -1 if i < other, 0 if i == other, and +1 if i > other.
+// Null is considered equal to the zero value, consistent with Equal.
+func (i Int32) Compare(other Int32) int {
+	return cmp.Compare(i.ValueOrZero(), other.ValueOrZero())
+}
@@ -2,8 +2,11 @@ package zero
 
 import (
 	"bytes"
+	"cmp"
 	"database/sql"
+	"encoding/binary"
 	"encoding/json"
+	"encoding/xml"
 	"errors"
 	"fmt"
 	"strconv"
@@ -48,6 +51,23 @@ func (i Int64) ValueOrZero() int64 {
 	return i.Int64
 }
 
+// OrElse returns the inner value if valid and non-zero, otherwise v.
+func (i Int64) OrElse(v int64) int64 {
+	if i.IsZero() {
+		return v
+	}
+	return i.Int64
+}
+
+// OrElseGet returns the inner value if valid and non-zero, otherwise the result of calling f.
+// f is only called if this Int64 is null or zero.
+func (i Int64) OrElseGet(f func() int64) int64 {
+	if i.IsZero() {
+		return f()
+	}
+	return i.Int64
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 // It supports number and null input.
 // 0 will be considered a null Int64.
@@ -57,6 +77,12 @@ func (i *Int64) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
+	if n, ok := parseJSONInt(data, 64); ok {
+		i.Int64 = n
+		i.Valid = n != 0
+		return nil
+	}
+
 	if err := json.Unmarshal(data, &i.Int64); err != nil {
 		var typeError *json.UnmarshalTypeError
 		if errors.As(err, &typeError) {
@@ -104,21 +130,111 @@ func (i *Int64) UnmarshalText(text []byte) error {
 // MarshalJSON implements json.Marshaler.
 // It will encode 0 if this Int64 is null.
 func (i Int64) MarshalJSON() ([]byte, error) {
+	return i.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of i to dst, the same as MarshalJSON.
+func (i Int64) AppendJSON(dst []byte) ([]byte, error) {
 	n := i.Int64
 	if !i.Valid {
 		n = 0
 	}
-	return []byte(strconv.FormatInt(n, 10)), nil
+	return strconv.AppendInt(dst, n, 10), nil
 }
 
 // MarshalText implements encoding.TextMarshaler.
 // It will encode a zero if this Int64 is null.
 func (i Int64) MarshalText() ([]byte, error) {
+	return i.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append a zero if this Int64 is null.
+func (i Int64) AppendText(dst []byte) ([]byte, error) {
 	n := i.Int64
 	if !i.Valid {
 		n = 0
 	}
-	return []byte(strconv.FormatInt(n, 10)), nil
+	return strconv.AppendInt(dst, n, 10), nil
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode null as the zero value; see XMLEmpty, XMLOmit and XMLNil for other encodings.
+func (i Int64) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, xmlNullZero, i.IsZero(), i)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// It will unmarshal to a null Int64 if the element is empty or has xsi:nil="true".
+func (i *Int64) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, i)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will encode null as the zero value; see XMLEmpty and XMLOmit for other encodings.
+func (i Int64) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, xmlNullZero, i.IsZero(), i)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+// It will unmarshal to a null Int64 if the attribute is empty.
+func (i *Int64) UnmarshalXMLAttr(attr xml.Attr) error {
+	return i.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It encodes a version byte and a validity byte; valid values are followed by the value as 8 big-endian bytes.
+func (i Int64) MarshalBinary() ([]byte, error) {
+	if !i.Valid {
+		return binaryNull(), nil
+	}
+	return binary.BigEndian.AppendUint64(binaryHeader(8), uint64(i.Int64)), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// It decodes the encoding produced by MarshalBinary.
+func (i *Int64) UnmarshalBinary(data []byte) error {
+	value, valid, err := readBinary(data, 8)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		*i = NewInt64(0, false)
+		return nil
+	}
+	*i = NewInt64(int64(binary.BigEndian.Uint64(value)), true)
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using MarshalBinary.
+func (i Int64) GobEncode() ([]byte, error) {
+	return i.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using UnmarshalBinary.
+func (i *Int64) GobDecode(data []byte) error {
+	return i.UnmarshalBinary(data)
+}
+
+// MarshalYAML implements yaml.Marshaler from gopkg.in/yaml.v3 and compatible packages.
+// It will encode the zero value if this Int64 is null.
+func (i Int64) MarshalYAML() (any, error) {
+	return i.ValueOrZero(), nil
+}
+
+// UnmarshalYAML implements the unmarshaler interface of gopkg.in/yaml.v2, which gopkg.in/yaml.v3 also supports.
+// It supports integer and null input.
+func (i *Int64) UnmarshalYAML(unmarshal func(any) error) error {
+	var v *int64
+	if err := unmarshal(&v); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal YAML: %w", err)
+	}
+	if v == nil {
+		*i = NewInt64(0, false)
+		return nil
+	}
+	*i = Int64From(*v)
+	return nil
 }
 
 // SetValid changes this Int64's value and also sets it to be non-null.
@@ -144,3 +260,10 @@ func (i Int64) IsZero() bool {
 func (i Int64) Equal(other Int64) bool {
 	return i.ValueOrZero() == other.ValueOrZero()
 }
+
+// Compare returns an integer comparing two Int64s, for use with slices.SortFunc and similar.
+// The result is -1 if i < other, 0 if i == other, and +1 if i > other.
+// Null is considered equal to the zero value, consistent with Equal.
+func (i Int64) Compare(other Int64) int {
+	return cmp.Compare(i.ValueOrZero(), other.ValueOrZero())
+}
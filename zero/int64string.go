@@ -0,0 +1,58 @@
+package zero
+
+import (
+	"strconv"
+)
+
+// Int64String is a nullable int64 that encodes to a JSON string, such as "12345678901234567",
+// for JavaScript clients that can't represent integers above 2^53 exactly.
+// It behaves exactly like Int64 otherwise: UnmarshalJSON accepts both numbers and strings,
+// and SQL, text, XML and binary encoding are the same.
+// JSON null or zero input will be considered null, and null will encode to "0".
+//
+// Methods promoted from Int64 and the package's generic helpers work on the embedded Int64.
+// Wrap Int64 results in Int64String{...} before encoding them to JSON,
+// or they will be encoded as numbers.
+type Int64String struct {
+	Int64
+}
+
+// NewInt64String creates a new Int64String
+func NewInt64String(i int64, valid bool) Int64String {
+	return Int64String{Int64: NewInt64(i, valid)}
+}
+
+// Int64StringFrom creates a new Int64String that will be null if i is zero.
+func Int64StringFrom(i int64) Int64String {
+	return Int64String{Int64: Int64From(i)}
+}
+
+// Int64StringFromPtr creates a new Int64String that will be null if i is nil.
+func Int64StringFromPtr(i *int64) Int64String {
+	return Int64String{Int64: Int64FromPtr(i)}
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode "0" if this Int64String is null, and the number as a string otherwise.
+func (i Int64String) MarshalJSON() ([]byte, error) {
+	return i.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of i to dst, the same as MarshalJSON.
+func (i Int64String) AppendJSON(dst []byte) ([]byte, error) {
+	dst = append(dst, '"')
+	dst = strconv.AppendInt(dst, i.ValueOrZero(), 10)
+	return append(dst, '"'), nil
+}
+
+// Equal returns true if both ints have the same value or are both either null or zero.
+func (i Int64String) Equal(other Int64String) bool {
+	return i.Int64.Equal(other.Int64)
+}
+
+// Compare returns an integer comparing two Int64Strings, for use with slices.SortFunc and similar.
+// The result is -1 if i < other, 0 if i == other, and +1 if i > other.
+// Null is considered equal to the zero value, consistent with Equal.
+func (i Int64String) Compare(other Int64String) int {
+	return i.Int64.Compare(other.Int64)
+}
@@ -0,0 +1,249 @@
+package zero
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// This file has the JSON literal parsing behind the fast paths of UnmarshalJSON.
+// The fast paths only handle input that encoding/json decodes the same way without reporting an error;
+// anything else is left to encoding/json, so that errors and edge cases stay the same.
+
+// isJSONNumber reports whether data is a JSON number literal.
+func isJSONNumber(data []byte) bool {
+	i := 0
+	if i < len(data) && data[i] == '-' {
+		i++
+	}
+	switch {
+	case i < len(data) && data[i] == '0':
+		i++
+	case i < len(data) && data[i] >= '1' && data[i] <= '9':
+		i = skipDigits(data, i)
+	default:
+		return false
+	}
+	if i < len(data) && data[i] == '.' {
+		start := i + 1
+		if i = skipDigits(data, start); i == start {
+			return false
+		}
+	}
+	if i < len(data) && (data[i] == 'e' || data[i] == 'E') {
+		i++
+		if i < len(data) && (data[i] == '+' || data[i] == '-') {
+			i++
+		}
+		start := i
+		if i = skipDigits(data, start); i == start {
+			return false
+		}
+	}
+	return i == len(data)
+}
+
+func skipDigits(data []byte, i int) int {
+	for i < len(data) && data[i] >= '0' && data[i] <= '9' {
+		i++
+	}
+	return i
+}
+
+// isJSONInt reports whether data is a JSON number literal without a fraction or exponent.
+func isJSONInt(data []byte) bool {
+	return isJSONNumber(data) && bytes.IndexAny(data, ".eE") < 0
+}
+
+// parseJSONInt parses data if it is a JSON integer, or a JSON string without escapes holding one,
+// that fits in bitSize bits.
+func parseJSONInt(data []byte, bitSize int) (int64, bool) {
+	if str, ok := plainJSONString(data); ok {
+		data = str
+	} else if !isJSONInt(data) {
+		return 0, false
+	}
+	n, err := strconv.ParseInt(string(data), 10, bitSize)
+	return n, err == nil
+}
+
+// parseJSONFloat parses data if it is a JSON number, or a JSON string without escapes holding one,
+// that fits in a float64.
+func parseJSONFloat(data []byte) (float64, bool) {
+	if str, ok := plainJSONString(data); ok {
+		data = str
+	} else if !isJSONNumber(data) {
+		return 0, false
+	}
+	f, err := strconv.ParseFloat(string(data), 64)
+	return f, err == nil
+}
+
+// plainJSONString returns the contents of data if it is a JSON string that decodes to its contents unchanged,
+// because it has no escape sequences and is valid UTF-8.
+func plainJSONString(data []byte) ([]byte, bool) {
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return nil, false
+	}
+	s := data[1 : len(data)-1]
+	for _, c := range s {
+		if c == '"' || c == '\\' || c < ' ' {
+			return nil, false
+		}
+	}
+	if !utf8.Valid(s) {
+		return nil, false
+	}
+	return s, true
+}
+
+// unquoteJSONString decodes data if it is a JSON string.
+// It returns false for invalid UTF-8 and unpaired surrogates, which encoding/json replaces with U+FFFD.
+func unquoteJSONString(data []byte) (string, bool) {
+	if s, ok := plainJSONString(data); ok {
+		return string(s), true
+	}
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return "", false
+	}
+	s := data[1 : len(data)-1]
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 0; i < len(s); {
+		switch c := s[i]; {
+		case c == '\\':
+			if i+1 == len(s) {
+				return "", false
+			}
+			if s[i+1] == 'u' {
+				r, ok := parseHex4(s[i+2:])
+				if !ok {
+					return "", false
+				}
+				i += 6
+				if utf16.IsSurrogate(r) {
+					if i+1 >= len(s) || s[i] != '\\' || s[i+1] != 'u' {
+						return "", false
+					}
+					r2, ok := parseHex4(s[i+2:])
+					if r = utf16.DecodeRune(r, r2); !ok || r == utf8.RuneError {
+						return "", false
+					}
+					i += 6
+				}
+				b.WriteRune(r)
+				continue
+			}
+			switch s[i+1] {
+			case '"', '\\', '/':
+				b.WriteByte(s[i+1])
+			case 'b':
+				b.WriteByte('\b')
+			case 'f':
+				b.WriteByte('\f')
+			case 'n':
+				b.WriteByte('\n')
+			case 'r':
+				b.WriteByte('\r')
+			case 't':
+				b.WriteByte('\t')
+			default:
+				return "", false
+			}
+			i += 2
+		case c == '"' || c < ' ':
+			return "", false
+		case c < utf8.RuneSelf:
+			b.WriteByte(c)
+			i++
+		default:
+			r, size := utf8.DecodeRune(s[i:])
+			if r == utf8.RuneError && size == 1 {
+				return "", false
+			}
+			b.Write(s[i : i+size])
+			i += size
+		}
+	}
+	return b.String(), true
+}
+
+// parseHex4 parses the four hex digits at the start of b.
+func parseHex4(b []byte) (rune, bool) {
+	if len(b) < 4 {
+		return 0, false
+	}
+	var r rune
+	for _, c := range b[:4] {
+		switch {
+		case c >= '0' && c <= '9':
+			c -= '0'
+		case c >= 'a' && c <= 'f':
+			c -= 'a' - 10
+		case c >= 'A' && c <= 'F':
+			c -= 'A' - 10
+		default:
+			return 0, false
+		}
+		r = r<<4 | rune(c)
+	}
+	return r, true
+}
+
+const hexDigits = "0123456789abcdef"
+
+// appendJSONString appends s to b as a JSON string, the same as encoding/json:
+// HTML characters, U+2028 and U+2029 are escaped, and invalid UTF-8 is replaced with U+FFFD.
+// Like encoding/json/v2, the replacement character is written as is rather than as \ufffd.
+func appendJSONString(b []byte, s string) []byte {
+	b = append(b, '"')
+	start := 0
+	for i := 0; i < len(s); {
+		if c := s[i]; c < utf8.RuneSelf {
+			if c >= ' ' && c != '"' && c != '\\' && c != '<' && c != '>' && c != '&' {
+				i++
+				continue
+			}
+			b = append(b, s[start:i]...)
+			switch c {
+			case '"', '\\':
+				b = append(b, '\\', c)
+			case '\b':
+				b = append(b, '\\', 'b')
+			case '\f':
+				b = append(b, '\\', 'f')
+			case '\n':
+				b = append(b, '\\', 'n')
+			case '\r':
+				b = append(b, '\\', 'r')
+			case '\t':
+				b = append(b, '\\', 't')
+			default:
+				b = append(b, '\\', 'u', '0', '0', hexDigits[c>>4], hexDigits[c&0xf])
+			}
+			i++
+			start = i
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			b = append(b, s[start:i]...)
+			b = append(b, string(utf8.RuneError)...)
+			i += size
+			start = i
+			continue
+		}
+		if r == '\u2028' || r == '\u2029' {
+			b = append(b, s[start:i]...)
+			b = append(b, '\\', 'u', '2', '0', '2', hexDigits[r&0xf])
+			i += size
+			start = i
+			continue
+		}
+		i += size
+	}
+	b = append(b, s[start:]...)
+	return append(b, '"')
+}
@@ -0,0 +1,97 @@
+package zero
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var jsonLiterals = []string{
+	`0`, `-0`, `12345`, `-12345`, `01`, `+1`, `-`, `1.`, `.5`, `1.5`, `-1.5e10`, `1e3`, `1E+3`, `1e-3`, `1e`, `1e+`, `0x10`,
+	`9223372036854775807`, `9223372036854775808`, `-9223372036854775808`, `2147483647`, `2147483648`, `1e400`, `1e-400`,
+	`"123"`, `"-0"`, `"+5"`, `"007"`, `" 1"`, `"1.5"`, `"1e3"`, `"NaN"`, `"Inf"`, `"0x10"`, `"1_000"`, `"1"`,
+	`""`, `"test"`, `"a\"b"`, `"\\\/\b\f\n\r\t"`, `"é日"`, `"😀"`, `"\ud83d"`, `"\ude00x"`, `"\ud83dx\ude00"`,
+	`"\ud83dA"`, `"\u12"`, `"\u12g4"`, `"\x"`, `"\"`, "\"tab\there\"", `"é日本"`, "\"\xff\"", "\"é\xff\"", "\"\xed\xa0\x80\"",
+	`"unterminated`, `"a"b"`, `"<&>"`, `true`, `false`, `null`, `tru`, `[]`, `{}`, ` 1`, `1 `, ``, `"true"`, `"yes"`, `"0"`,
+}
+
+func TestJSONLiteralParsing(t *testing.T) {
+	for _, in := range jsonLiterals {
+		data := []byte(in)
+
+		wantNumber := json.Valid(data) && in == strings.TrimSpace(in) && strings.ContainsAny(in[:1], "-0123456789")
+		if got := isJSONNumber(data); got != wantNumber {
+			t.Errorf("isJSONNumber(%s): got %v, want %v", in, got, wantNumber)
+		}
+
+		if n, ok := parseJSONInt(data, 64); ok {
+			if want, ok := decodeJSONNumber(in, strconv.ParseInt); !ok || n != want {
+				t.Errorf("parseJSONInt(%s): got %d, want %d (ok %v)", in, n, want, ok)
+			}
+		}
+		if n, ok := parseJSONInt(data, 32); ok {
+			if want, ok := decodeJSONNumber(in, func(s string, base, bitSize int) (int64, error) { return strconv.ParseInt(s, base, 32) }); !ok || n != want {
+				t.Errorf("parseJSONInt(%s, 32): got %d, want %d (ok %v)", in, n, want, ok)
+			}
+		}
+		if f, ok := parseJSONFloat(data); ok {
+			parse := func(s string, _, _ int) (float64, error) { return strconv.ParseFloat(s, 64) }
+			if want, ok := decodeJSONNumber(in, parse); !ok || (f != want && !(math.IsNaN(f) && math.IsNaN(want))) {
+				t.Errorf("parseJSONFloat(%s): got %v, want %v (ok %v)", in, f, want, ok)
+			}
+		}
+
+		var want string
+		err := json.Unmarshal(data, &want)
+		if s, ok := plainJSONString(data); ok && (err != nil || string(s) != want) {
+			t.Errorf("plainJSONString(%s): got %q, want %q (error %v)", in, s, want, err)
+		}
+		s, ok := unquoteJSONString(data)
+		if ok && (err != nil || s != want) {
+			t.Errorf("unquoteJSONString(%s): got %q, want %q (error %v)", in, s, want, err)
+		}
+		// only strings that encoding/json changes with replacement characters are left to it
+		if !ok && err == nil && in != "null" && !strings.ContainsRune(want, '�') {
+			t.Errorf("unquoteJSONString(%s): not decoded, want %q", in, want)
+		}
+	}
+}
+
+func TestJSONFastPaths(t *testing.T) {
+	for _, in := range []string{`12345`, `-1`, `"12345"`, `"-1"`} {
+		if _, ok := parseJSONInt([]byte(in), 64); !ok {
+			t.Errorf("parseJSONInt(%s): not parsed", in)
+		}
+	}
+	for _, in := range []string{`1.5`, `-1e-3`, `"1.5"`, `"NaN"`} {
+		if _, ok := parseJSONFloat([]byte(in)); !ok {
+			t.Errorf("parseJSONFloat(%s): not parsed", in)
+		}
+	}
+	for _, in := range []string{`"test"`, `"é日本"`} {
+		if _, ok := plainJSONString([]byte(in)); !ok {
+			t.Errorf("plainJSONString(%s): not parsed", in)
+		}
+	}
+}
+
+// decodeJSONNumber decodes a number the way UnmarshalJSON does with encoding/json:
+// a JSON number, or a JSON string parsed with parse.
+func decodeJSONNumber[T int64 | float64](in string, parse func(s string, base, bitSize int) (T, error)) (T, bool) {
+	var n T
+	if json.Unmarshal([]byte(in), &n) == nil {
+		// parse also checks the range, for int32
+		if _, err := parse(in, 10, 64); err == nil {
+			return n, true
+		}
+		return n, false
+	}
+	var str string
+	if err := json.Unmarshal([]byte(in), &str); err != nil {
+		return n, false
+	}
+	n, err := parse(str, 10, 64)
+	return n, err == nil
+}
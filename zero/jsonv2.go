@@ -0,0 +1,245 @@
+//go:build goexperiment.jsonv2
+
+package zero
+
+import (
+	"encoding/json/jsontext"
+	"encoding/json/v2"
+	"time"
+	"unicode/utf8"
+)
+
+// This file implements the streaming methods of encoding/json/v2, which is built by default since Go 1.27,
+// and with GOEXPERIMENT=jsonv2 in Go 1.25 and 1.26.
+// They produce and accept the same JSON as MarshalJSON and UnmarshalJSON.
+// Marshaling avoids allocations in the common cases, and unmarshaling uses the fast paths of UnmarshalJSON.
+
+// MarshalJSONTo implements json.MarshalerTo from encoding/json/v2.
+// It will encode 0 if this Int64 is null.
+func (i Int64) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return enc.WriteToken(jsontext.Int(i.ValueOrZero()))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom from encoding/json/v2.
+// It supports number, string, and null input, like UnmarshalJSON.
+// 0 will be considered a null Int64.
+func (i *Int64) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	data, err := dec.ReadValue()
+	if err != nil {
+		return err
+	}
+	return i.UnmarshalJSON(data)
+}
+
+// MarshalJSONTo implements json.MarshalerTo from encoding/json/v2.
+// It will encode "0" if this Int64String is null, and the number as a string otherwise.
+func (i Int64String) MarshalJSONTo(enc *jsontext.Encoder) error {
+	var buf [32]byte
+	b, _ := i.AppendJSON(buf[:0])
+	return enc.WriteValue(b)
+}
+
+// MarshalJSONTo implements json.MarshalerTo from encoding/json/v2.
+// It will encode 0 if this Int32 is null.
+func (i Int32) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return enc.WriteToken(jsontext.Int(int64(i.ValueOrZero())))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom from encoding/json/v2.
+// It supports number, string, and null input, like UnmarshalJSON.
+// 0 will be considered a null Int32.
+func (i *Int32) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	data, err := dec.ReadValue()
+	if err != nil {
+		return err
+	}
+	return i.UnmarshalJSON(data)
+}
+
+// MarshalJSONTo implements json.MarshalerTo from encoding/json/v2.
+// It will encode 0 if this Float is null, and return an error for NaN and infinity, like MarshalJSON.
+func (f Float) MarshalJSONTo(enc *jsontext.Encoder) error {
+	var buf [64]byte
+	b, err := f.AppendJSON(buf[:0])
+	if err != nil {
+		return err
+	}
+	return enc.WriteValue(b)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom from encoding/json/v2.
+// It supports number, string, and null input, like UnmarshalJSON.
+// 0 will be considered a null Float.
+func (f *Float) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	data, err := dec.ReadValue()
+	if err != nil {
+		return err
+	}
+	return f.UnmarshalJSON(data)
+}
+
+// MarshalJSONTo implements json.MarshalerTo from encoding/json/v2.
+// It will encode 0 if this FloatNaNAsNull is null, NaN or infinite, like MarshalJSON.
+func (f FloatNaNAsNull) MarshalJSONTo(enc *jsontext.Encoder) error {
+	var buf [64]byte
+	b, _ := f.AppendJSON(buf[:0])
+	return enc.WriteValue(b)
+}
+
+// MarshalJSONTo implements json.MarshalerTo from encoding/json/v2.
+// It will encode 0 if this FloatNaNAsString is null, and NaN and infinity as JSON strings, like MarshalJSON.
+func (f FloatNaNAsString) MarshalJSONTo(enc *jsontext.Encoder) error {
+	var buf [64]byte
+	b, _ := f.AppendJSON(buf[:0])
+	return enc.WriteValue(b)
+}
+
+// MarshalJSONTo implements json.MarshalerTo from encoding/json/v2.
+// It will encode false if this Bool is null.
+func (b Bool) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return enc.WriteToken(jsontext.Bool(b.Valid && b.Bool))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom from encoding/json/v2.
+// It supports boolean and null input, like UnmarshalJSON.
+// false will be considered a null Bool.
+func (b *Bool) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	data, err := dec.ReadValue()
+	if err != nil {
+		return err
+	}
+	return b.UnmarshalJSON(data)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom from encoding/json/v2.
+// It supports boolean, null, the numbers 0 and 1, and string input, like UnmarshalJSON.
+func (b *LenientBool) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	data, err := dec.ReadValue()
+	if err != nil {
+		return err
+	}
+	return b.UnmarshalJSON(data)
+}
+
+// MarshalJSONTo implements json.MarshalerTo from encoding/json/v2.
+// It will encode a blank string if this String is null, like MarshalText.
+// Characters are escaped according to the options of enc, so HTML characters are only escaped
+// when called by encoding/json, but invalid UTF-8 is always replaced.
+func (s String) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !utf8.ValidString(s.ValueOrZero()) {
+		data, _ := s.AppendJSON(nil)
+		return enc.WriteValue(data)
+	}
+	return enc.WriteToken(jsontext.String(s.ValueOrZero()))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom from encoding/json/v2.
+// It supports string and null input, like UnmarshalJSON.
+// Blank string input produces a null String.
+func (s *String) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	data, err := dec.ReadValue()
+	if err != nil {
+		return err
+	}
+	return s.UnmarshalJSON(data)
+}
+
+// MarshalJSONTo implements json.MarshalerTo from encoding/json/v2.
+// It will encode the zero value of time.Time if this time is invalid.
+func (t Time) MarshalJSONTo(enc *jsontext.Encoder) error {
+	var buf [64]byte
+	b, err := t.AppendJSON(buf[:0])
+	if err != nil {
+		return err
+	}
+	return enc.WriteValue(b)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom from encoding/json/v2.
+// It supports string and null input, like UnmarshalJSON.
+func (t *Time) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	data, err := dec.ReadValue()
+	if err != nil {
+		return err
+	}
+	return t.UnmarshalJSON(data)
+}
+
+// MarshalJSONTo implements json.MarshalerTo from encoding/json/v2.
+// It will encode 0 if this time is null, and milliseconds since the Unix epoch otherwise.
+func (t Timestamp) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !t.Valid {
+		return enc.WriteToken(jsontext.Int(0))
+	}
+	return enc.WriteToken(jsontext.Int(t.Time.UnixNano() / int64(time.Millisecond)))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom from encoding/json/v2.
+// It supports number, string and null input, like UnmarshalJSON.
+func (t *Timestamp) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	data, err := dec.ReadValue()
+	if err != nil {
+		return err
+	}
+	return t.UnmarshalJSON(data)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom from encoding/json/v2.
+// It supports integer and null input, like UnmarshalJSON.
+func (i *StrictInt64) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	data, err := dec.ReadValue()
+	if err != nil {
+		return err
+	}
+	return i.UnmarshalJSON(data)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom from encoding/json/v2.
+// It supports integer and null input, like UnmarshalJSON.
+func (i *StrictInt32) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	data, err := dec.ReadValue()
+	if err != nil {
+		return err
+	}
+	return i.UnmarshalJSON(data)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom from encoding/json/v2.
+// It supports number and null input, like UnmarshalJSON.
+func (f *StrictFloat) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	data, err := dec.ReadValue()
+	if err != nil {
+		return err
+	}
+	return f.UnmarshalJSON(data)
+}
+
+// MarshalJSONTo implements json.MarshalerTo from encoding/json/v2, using the wrapped value.
+func (x XMLEmpty[T]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return json.MarshalEncode(enc, x.V)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom from encoding/json/v2, using the wrapped value.
+func (x *XMLEmpty[T]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return json.UnmarshalDecode(dec, &x.V)
+}
+
+// MarshalJSONTo implements json.MarshalerTo from encoding/json/v2, using the wrapped value.
+func (x XMLOmit[T]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return json.MarshalEncode(enc, x.V)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom from encoding/json/v2, using the wrapped value.
+func (x *XMLOmit[T]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return json.UnmarshalDecode(dec, &x.V)
+}
+
+// MarshalJSONTo implements json.MarshalerTo from encoding/json/v2, using the wrapped value.
+func (x XMLNil[T]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return json.MarshalEncode(enc, x.V)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom from encoding/json/v2, using the wrapped value.
+func (x *XMLNil[T]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return json.UnmarshalDecode(dec, &x.V)
+}
@@ -0,0 +1,129 @@
+//go:build goexperiment.jsonv2
+
+package zero
+
+import (
+	"encoding/json"
+	"encoding/json/jsontext"
+	jsonv2 "encoding/json/v2"
+	"io"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestUnmarshalJSONFrom(t *testing.T) {
+	numbers := []string{`12345`, `-1`, `0`, `"12345"`, `"0"`, `""`, `"x"`, `1.5`, `1e3`, `9223372036854775808`, `2147483648`, `true`, `null`, `{}`, `[1]`}
+	assertUnmarshalJSONFrom[Int64](t, numbers)
+	assertUnmarshalJSONFrom[Int64String](t, numbers)
+	assertUnmarshalJSONFrom[XMLEmpty[Int64]](t, numbers)
+	assertUnmarshalJSONFrom[XMLOmit[Int64]](t, numbers)
+	assertUnmarshalJSONFrom[XMLNil[Int64]](t, numbers)
+	assertUnmarshalJSONFrom[Int32](t, numbers)
+	assertUnmarshalJSONFrom[StrictInt64](t, numbers)
+	assertUnmarshalJSONFrom[StrictInt32](t, numbers)
+	assertUnmarshalJSONFrom[Float](t, append(numbers, `1e400`, `"1e400"`, `-0`))
+	assertUnmarshalJSONFrom[StrictFloat](t, append(numbers, `1e400`, `"1e400"`, `-0`))
+	assertUnmarshalJSONFrom[Bool](t, []string{`true`, `false`, `null`, `0`, `"true"`, `{}`})
+	assertUnmarshalJSONFrom[LenientBool](t, []string{`true`, `false`, `null`, `0`, `1`, `2`, `"yes"`, `"off"`, `""`, `"maybe"`, `{}`})
+	assertUnmarshalJSONFrom[String](t, []string{`"test"`, `""`, `"a\"bé"`, `"<&>"`, `null`, `1`, `true`, `{}`})
+	assertUnmarshalJSONFrom[XMLNil[String]](t, []string{`"test"`, `""`, `"a\"bé"`, `"<&>"`, `null`, `1`, `true`, `{}`})
+	assertUnmarshalJSONFrom[Time](t, []string{`"2012-12-21T21:21:21Z"`, `"2012-12-21T22:21:21.5+01:00"`, `"0001-01-01T00:00:00Z"`, `"2012-12-21"`, `""`, `null`, `1`, `{}`})
+	assertUnmarshalJSONFrom[Timestamp](t, []string{`1356124881000`, `-1`, `0`, `"1356124881000"`, `1.5`, `null`, `"x"`, `{}`})
+}
+
+func TestMarshalJSONTo(t *testing.T) {
+	tests := []struct {
+		v    any
+		want string
+	}{
+		{Int64From(math.MinInt64), `-9223372036854775808`},
+		{NewInt64(12345, false), `0`},
+		{Int64StringFrom(math.MaxInt64), `"9223372036854775807"`},
+		{Int64String{}, `"0"`},
+		{XMLEmpty[Int64]{Int64From(12345)}, `12345`},
+		{XMLEmpty[Int64]{}, `0`},
+		{XMLOmit[Int64]{Int64From(12345)}, `12345`},
+		{XMLOmit[Int64]{}, `0`},
+		{XMLNil[Int64]{Int64From(12345)}, `12345`},
+		{XMLNil[Int64]{}, `0`},
+		{Int32From(math.MaxInt32), `2147483647`},
+		{Int32{}, `0`},
+		{FloatFrom(1.2345), `1.2345`},
+		{FloatFrom(1e21), `1000000000000000000000`},
+		{NewFloat(1.5, false), `0`},
+		{BoolFrom(true), `true`},
+		{NewBool(true, false), `false`},
+		{LenientBool{Bool: BoolFrom(true)}, `true`},
+		{StringFrom("test"), `"test"`},
+		{StringFrom("a\"b\né"), `"a\"b\né"`},
+		{StringFrom("\xffinvalid"), `"�invalid"`},
+		{NewString("test", false), `""`},
+		{TimeFrom(timeValue1), `"` + timeString1 + `"`},
+		{TimeFrom(time.Date(2012, 12, 21, 22, 21, 21, 500, time.FixedZone("", 3600))), `"2012-12-21T22:21:21.0000005+01:00"`},
+		{Time{}, `"` + zeroTimeStr + `"`},
+		{TimestampFrom(timeValue1), `1356124881000`},
+		{TimestampFrom(time.UnixMilli(-1500)), `-1500`},
+		{Timestamp{}, `0`},
+	}
+	for _, test := range tests {
+		got, err := jsonv2.Marshal(test.v)
+		if err != nil || string(got) != test.want {
+			t.Errorf("json/v2 Marshal(%#v): got %s, %v, want %s", test.v, got, err, test.want)
+		}
+		// encoding/json gives the same result as before
+		got, err = json.Marshal(test.v)
+		if err != nil || string(got) != test.want {
+			t.Errorf("json Marshal(%#v): got %s, %v, want %s", test.v, got, err, test.want)
+		}
+	}
+
+	for _, v := range []any{FloatFrom(math.NaN()), FloatFrom(math.Inf(1)), TimeFrom(time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC))} {
+		if _, err := jsonv2.Marshal(v); err == nil {
+			t.Errorf("json/v2 Marshal(%#v): expected error", v)
+		}
+	}
+
+	// HTML characters are escaped by encoding/json, but not by default in encoding/json/v2
+	s := StringFrom("<&>")
+	if got, err := json.Marshal(s); err != nil || string(got) != `"\u003c\u0026\u003e"` {
+		t.Errorf("json Marshal(%q): got %s, %v", s.String, got, err)
+	}
+	if got, err := jsonv2.Marshal(s); err != nil || string(got) != `"<&>"` {
+		t.Errorf("json/v2 Marshal(%q): got %s, %v", s.String, got, err)
+	}
+}
+
+func TestMarshalJSONToAllocs(t *testing.T) {
+	enc := jsontext.NewEncoder(io.Discard)
+	values := []jsonv2.MarshalerTo{Int64From(12345), Int64StringFrom(12345), Int32{}, FloatFrom(1.5), BoolFrom(true), StringFrom("test"), String{}, TimeFrom(timeValue1), Time{}, TimestampFrom(timeValue1)}
+	for _, v := range values {
+		allocs := testing.AllocsPerRun(100, func() {
+			maybePanic(v.MarshalJSONTo(enc))
+		})
+		if allocs > 0 {
+			t.Errorf("MarshalJSONTo(%#v): %v allocations, want 0", v, allocs)
+		}
+	}
+}
+
+// assertUnmarshalJSONFrom checks that encoding/json/v2 unmarshals each input to the same value as UnmarshalJSON,
+// and fails for the same inputs.
+func assertUnmarshalJSONFrom[T any, PT interface {
+	*T
+	json.Unmarshaler
+	jsonv2.UnmarshalerFrom
+}](t *testing.T, inputs []string) {
+	t.Helper()
+	for _, in := range inputs {
+		var want, got T
+		wantErr := PT(&want).UnmarshalJSON([]byte(in))
+		gotErr := jsonv2.Unmarshal([]byte(in), PT(&got))
+		if (gotErr == nil) != (wantErr == nil) {
+			t.Errorf("%T from %s: got error %v, want %v", got, in, gotErr, wantErr)
+		} else if !reflect.DeepEqual(got, want) {
+			t.Errorf("%T from %s: got %#v, want %#v", got, in, got, want)
+		}
+	}
+}
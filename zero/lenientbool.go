@@ -0,0 +1,125 @@
+package zero
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// LenientBool is a nullable bool that accepts the common textual spellings of booleans.
+// It behaves exactly like Bool, except that UnmarshalText and UnmarshalJSON
+// also accept 1/0, t/f, yes/no and on/off (case-insensitive).
+// As with Bool, any false input is considered null.
+type LenientBool struct {
+	Bool
+}
+
+// NewLenientBool creates a new LenientBool
+func NewLenientBool(b bool, valid bool) LenientBool {
+	return LenientBool{Bool: NewBool(b, valid)}
+}
+
+// LenientBoolFrom creates a new LenientBool that will be null if false.
+func LenientBoolFrom(b bool) LenientBool {
+	return NewLenientBool(b, b)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports boolean, null, the numbers 0 and 1, and string input.
+// Strings are parsed the same way as UnmarshalText.
+func (b *LenientBool) UnmarshalJSON(data []byte) error {
+	switch string(data) {
+	case "null":
+		b.Valid = false
+		return nil
+	case "true", "1":
+		b.SetValid(true)
+		return nil
+	case "false", "0":
+		b.Bool.Bool = false
+		b.Valid = false
+		return nil
+	}
+
+	if str, ok := plainJSONString(data); ok {
+		return b.UnmarshalText(str)
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		var typeError *json.UnmarshalTypeError
+		if errors.As(err, &typeError) {
+			return fmt.Errorf("zero: JSON input is invalid type (need bool, 0, 1 or string): %w", err)
+		}
+		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	}
+	return b.UnmarshalText([]byte(str))
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null LenientBool if the input is false, blank or "null".
+// It will return an error if the input is not a recognized boolean spelling.
+func (b *LenientBool) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		b.Valid = false
+		return nil
+	}
+	v, ok := parseLenientBool(str)
+	if !ok {
+		return errors.New("zero: invalid input for UnmarshalText:" + str)
+	}
+	b.Bool.Bool = v
+	b.Valid = v
+	return nil
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// It accepts the same spellings as UnmarshalText.
+func (b *LenientBool) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, b)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+// It accepts the same spellings as UnmarshalText.
+func (b *LenientBool) UnmarshalXMLAttr(attr xml.Attr) error {
+	return b.UnmarshalText([]byte(attr.Value))
+}
+
+// UnmarshalYAML implements the unmarshaler interface of gopkg.in/yaml.v2, which gopkg.in/yaml.v3 also supports.
+// It supports null, booleans, 0, 1, and the strings accepted by UnmarshalText.
+func (b *LenientBool) UnmarshalYAML(unmarshal func(any) error) error {
+	var v any
+	if err := unmarshal(&v); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal YAML: %w", err)
+	}
+	switch v := v.(type) {
+	case nil:
+		*b = NewLenientBool(false, false)
+	case bool:
+		*b = LenientBoolFrom(v)
+	case int:
+		if v != 0 && v != 1 {
+			return fmt.Errorf("zero: YAML input is invalid number (need 0 or 1): %d", v)
+		}
+		*b = LenientBoolFrom(v == 1)
+	case string:
+		return b.UnmarshalText([]byte(v))
+	default:
+		return fmt.Errorf("zero: YAML input is invalid type (need bool, 0, 1 or string): %T", v)
+	}
+	return nil
+}
+
+// parseLenientBool parses the textual spellings of booleans accepted by LenientBool.
+func parseLenientBool(str string) (value bool, ok bool) {
+	switch strings.ToLower(str) {
+	case "true", "t", "1", "yes", "on":
+		return true, true
+	case "false", "f", "0", "no", "off":
+		return false, true
+	}
+	return false, false
+}
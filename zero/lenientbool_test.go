@@ -0,0 +1,68 @@
+package zero
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestLenientBoolFrom(t *testing.T) {
+	b := LenientBoolFrom(true)
+	assertBool(t, b.Bool, "LenientBoolFrom()")
+
+	zero := LenientBoolFrom(false)
+	assertNullBool(t, zero.Bool, "LenientBoolFrom(false)")
+}
+
+func TestUnmarshalLenientBool(t *testing.T) {
+	for _, input := range []string{`true`, `1`, `"1"`, `"TRUE"`, `"yes"`, `"On"`, `"t"`} {
+		var b LenientBool
+		err := json.Unmarshal([]byte(input), &b)
+		maybePanic(err)
+		assertBool(t, b.Bool, "lenient bool json "+input)
+	}
+
+	for _, input := range []string{`false`, `0`, `"0"`, `"False"`, `"no"`, `"OFF"`, `"f"`, `null`, `""`} {
+		var b LenientBool
+		err := json.Unmarshal([]byte(input), &b)
+		maybePanic(err)
+		assertNullBool(t, b.Bool, "lenient bool json "+input)
+	}
+
+	var badString LenientBool
+	err := json.Unmarshal([]byte(`"maybe"`), &badString)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullBool(t, badString.Bool, "wrong string json")
+
+	var invalid LenientBool
+	err = invalid.UnmarshalJSON(invalidJSON)
+	var syntaxError *json.SyntaxError
+	if !errors.As(err, &syntaxError) {
+		t.Errorf("expected wrapped json.SyntaxError, not %T", err)
+	}
+}
+
+func TestTextUnmarshalLenientBool(t *testing.T) {
+	for _, input := range []string{"true", "1", "T", "Yes", "on"} {
+		var b LenientBool
+		err := b.UnmarshalText([]byte(input))
+		maybePanic(err)
+		assertBool(t, b.Bool, "UnmarshalText() "+input)
+	}
+
+	for _, input := range []string{"false", "0", "F", "NO", "off", "", "null"} {
+		var b LenientBool
+		err := b.UnmarshalText([]byte(input))
+		maybePanic(err)
+		assertNullBool(t, b.Bool, "UnmarshalText() "+input)
+	}
+
+	var invalid LenientBool
+	err := invalid.UnmarshalText([]byte(":D"))
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullBool(t, invalid.Bool, "invalid text")
+}
@@ -0,0 +1,131 @@
+package zero
+
+import (
+	"github.com/vitdevelop/null"
+)
+
+// BoolFromNull creates a new Bool from a null.Bool.
+// It will be null if v is null or false.
+func BoolFromNull(v null.Bool) Bool {
+	if !v.Valid {
+		return NewBool(v.Bool, false)
+	}
+	return BoolFrom(v.Bool)
+}
+
+// ToNull converts this Bool to a null.Bool.
+// Because this package considers false values to be null, false values convert to null.
+func (b Bool) ToNull() null.Bool {
+	if b.IsZero() {
+		return null.NewBool(b.Bool, false)
+	}
+	return null.BoolFrom(b.Bool)
+}
+
+// FloatFromNull creates a new Float from a null.Float.
+// It will be null if v is null or zero.
+func FloatFromNull(v null.Float) Float {
+	if !v.Valid {
+		return NewFloat(v.Float64, false)
+	}
+	return FloatFrom(v.Float64)
+}
+
+// ToNull converts this Float to a null.Float.
+// Because this package considers zero values to be null, zero values convert to null.
+func (f Float) ToNull() null.Float {
+	if f.IsZero() {
+		return null.NewFloat(f.Float64, false)
+	}
+	return null.FloatFrom(f.Float64)
+}
+
+// Int32FromNull creates a new Int32 from a null.Int32.
+// It will be null if v is null or zero.
+func Int32FromNull(v null.Int32) Int32 {
+	if !v.Valid {
+		return NewInt32(v.Int32, false)
+	}
+	return Int32From(v.Int32)
+}
+
+// ToNull converts this Int32 to a null.Int32.
+// Because this package considers zero values to be null, zero values convert to null.
+func (i Int32) ToNull() null.Int32 {
+	if i.IsZero() {
+		return null.NewInt32(i.Int32, false)
+	}
+	return null.Int32From(i.Int32)
+}
+
+// Int64FromNull creates a new Int64 from a null.Int64.
+// It will be null if v is null or zero.
+func Int64FromNull(v null.Int64) Int64 {
+	if !v.Valid {
+		return NewInt64(v.Int64, false)
+	}
+	return Int64From(v.Int64)
+}
+
+// ToNull converts this Int64 to a null.Int64.
+// Because this package considers zero values to be null, zero values convert to null.
+func (i Int64) ToNull() null.Int64 {
+	if i.IsZero() {
+		return null.NewInt64(i.Int64, false)
+	}
+	return null.Int64From(i.Int64)
+}
+
+// StringFromNull creates a new String from a null.String.
+// It will be null if v is null or blank.
+func StringFromNull(v null.String) String {
+	if !v.Valid {
+		return NewString(v.String, false)
+	}
+	return StringFrom(v.String)
+}
+
+// ToNull converts this String to a null.String.
+// Because this package considers blank values to be null, blank values convert to null.
+func (s String) ToNull() null.String {
+	if s.IsZero() {
+		return null.NewString(s.String, false)
+	}
+	return null.StringFrom(s.String)
+}
+
+// TimeFromNull creates a new Time from a null.Time.
+// It will be null if v is null or zero.
+func TimeFromNull(v null.Time) Time {
+	if !v.Valid {
+		return NewTime(v.Time, false)
+	}
+	return TimeFrom(v.Time)
+}
+
+// ToNull converts this Time to a null.Time.
+// Because this package considers zero values to be null, zero values convert to null.
+func (t Time) ToNull() null.Time {
+	if t.IsZero() {
+		return null.NewTime(t.Time, false)
+	}
+	return null.TimeFrom(t.Time)
+}
+
+// TimestampFromNull creates a new Timestamp from a null.Timestamp.
+// It will be null if v is null or zero.
+func TimestampFromNull(v null.Timestamp) Timestamp {
+	if !v.Valid {
+		return NewTimestamp(v.Time, false)
+	}
+	return TimestampFrom(v.Time)
+}
+
+// ToNull converts this Timestamp to a null.Timestamp.
+// Because this package considers zero values to be null, zero values convert to null.
+func (t Timestamp) ToNull() null.Timestamp {
+	if t.IsZero() {
+		return null.NewTimestamp(t.Time, false)
+	}
+	return null.TimestampFrom(t.Time)
+}
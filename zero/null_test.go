@@ -0,0 +1,82 @@
+package zero
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/vitdevelop/null"
+)
+
+func TestFromNull(t *testing.T) {
+	assertStr(t, StringFromNull(null.StringFrom("test")), "StringFromNull()")
+	assertNullStr(t, StringFromNull(null.StringFrom("")), "StringFromNull() blank")
+	assertNullStr(t, StringFromNull(null.NewString("test", false)), "StringFromNull() null")
+
+	assertInt64(t, Int64FromNull(null.Int64From(12345)), "Int64FromNull()")
+	assertNullInt64(t, Int64FromNull(null.Int64From(0)), "Int64FromNull() zero")
+	assertNullInt32(t, Int32FromNull(null.Int32From(0)), "Int32FromNull() zero")
+	assertNullFloat(t, FloatFromNull(null.FloatFrom(0)), "FloatFromNull() zero")
+	assertBool(t, BoolFromNull(null.BoolFrom(true)), "BoolFromNull()")
+	assertNullBool(t, BoolFromNull(null.BoolFrom(false)), "BoolFromNull() false")
+	assertTime(t, TimeFromNull(null.TimeFrom(timeValue1)), "TimeFromNull()")
+	assertNullTime(t, TimeFromNull(null.TimeFrom(time.Time{})), "TimeFromNull() zero")
+	assertTimestamp(t, TimestampFromNull(null.TimestampFrom(timeValue1)), "TimestampFromNull()")
+	assertNullTimestamp(t, TimestampFromNull(null.NewTimestamp(timeValue1, false)), "TimestampFromNull() null")
+}
+
+func TestToNull(t *testing.T) {
+	if s := StringFrom("test").ToNull(); !s.Equal(null.StringFrom("test")) {
+		t.Errorf("ToNull() = %v, want valid test", s)
+	}
+	if s := NewString("", true).ToNull(); s.Valid {
+		t.Error("ToNull() of blank String is valid, but should be invalid")
+	}
+	if i := NewInt64(0, true).ToNull(); i.Valid {
+		t.Error("ToNull() of zero Int64 is valid, but should be invalid")
+	}
+	if i := Int32From(5).ToNull(); !i.Equal(null.Int32From(5)) {
+		t.Errorf("ToNull() = %v, want valid 5", i)
+	}
+	if f := NewFloat(1.5, false).ToNull(); f.Valid {
+		t.Error("ToNull() of null Float is valid, but should be invalid")
+	}
+	if b := NewBool(false, true).ToNull(); b.Valid {
+		t.Error("ToNull() of false Bool is valid, but should be invalid")
+	}
+	if ti := TimeFrom(timeValue1).ToNull(); !ti.ExactEqual(null.TimeFrom(timeValue1)) {
+		t.Errorf("ToNull() = %v, want %v", ti, timeValue1)
+	}
+	if ti := NewTimestamp(time.Time{}, true).ToNull(); ti.Valid {
+		t.Error("ToNull() of zero Timestamp is valid, but should be invalid")
+	}
+}
+
+func TestReencodeNullAsZero(t *testing.T) {
+	type nullRecord struct {
+		Name  null.String
+		Count null.Int64
+	}
+	type zeroRecord struct {
+		Name  String
+		Count Int64
+	}
+
+	var in nullRecord
+	err := json.Unmarshal([]byte(`{"Name":"","Count":null}`), &in)
+	maybePanic(err)
+	if !in.Name.Valid {
+		t.Error("null package should consider a blank string valid")
+	}
+
+	out := zeroRecord{Name: StringFromNull(in.Name), Count: Int64FromNull(in.Count)}
+	assertNullStr(t, out.Name, "re-encoded blank string")
+	data, err := json.Marshal(out)
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"Name":"","Count":0}`, "re-encoded zero record")
+
+	back := nullRecord{Name: out.Name.ToNull(), Count: out.Count.ToNull()}
+	data, err = json.Marshal(back)
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"Name":null,"Count":null}`, "re-encoded null record")
+}
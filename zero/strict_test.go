@@ -0,0 +1,107 @@
+package zero
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestUnmarshalStrictInt64(t *testing.T) {
+	var i StrictInt64
+	err := json.Unmarshal(intJSON, &i)
+	maybePanic(err)
+	assertInt64(t, i.Int64, "int json")
+
+	var null StrictInt64
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullInt64(t, null.Int64, "null json")
+
+	var invalid StrictInt64
+	err = invalid.UnmarshalJSON(invalidJSON)
+	var syntaxError *json.SyntaxError
+	if !errors.As(err, &syntaxError) {
+		t.Errorf("expected wrapped json.SyntaxError, not %T", err)
+	}
+
+	var overflow StrictInt64
+	if err := json.Unmarshal([]byte(`9223372036854775808`), &overflow); err == nil {
+		t.Error("expected error for overflow")
+	} else if !strings.Contains(err.Error(), "(need int)") {
+		t.Errorf("overflow error %q doesn't explain the type needed", err)
+	}
+
+	assertStrictRejects(t, new(StrictInt64), "int", `"12345"`, `12345.0`, `1.5`, `1e3`, `true`, `{}`, `[]`, ` 12345`, "12345\n", `99999999999999999999`)
+}
+
+func TestUnmarshalStrictInt32(t *testing.T) {
+	var i StrictInt32
+	err := json.Unmarshal(intJSON, &i)
+	maybePanic(err)
+	assertInt32(t, i.Int32, "int json")
+
+	var overflow StrictInt32
+	if err := json.Unmarshal([]byte(`2147483648`), &overflow); err == nil {
+		t.Error("expected error for overflow")
+	}
+
+	assertStrictRejects(t, new(StrictInt32), "int", `"12345"`, `12345.0`, `1e3`, `false`, ` 12345 `, `2147483648`, `99999999999999999999`)
+}
+
+func TestUnmarshalStrictFloat(t *testing.T) {
+	for _, input := range []string{`1.2345`, `12345e-4`} {
+		var f StrictFloat
+		err := json.Unmarshal([]byte(input), &f)
+		maybePanic(err)
+		assertFloat(t, f.Float, "float json "+input)
+	}
+
+	var i StrictFloat
+	err := json.Unmarshal(intJSON, &i)
+	maybePanic(err)
+	if !i.Valid || i.Float64 != 12345 {
+		t.Errorf("int json: got %v, want 12345", i.Float64)
+	}
+
+	assertStrictRejects(t, new(StrictFloat), "float", `"1.2345"`, `"NaN"`, `"Infinity"`, `true`, `[1]`, ` 1.2345`)
+}
+
+func TestStrictMarshal(t *testing.T) {
+	data, err := json.Marshal(StrictInt64From(12345))
+	maybePanic(err)
+	assertJSONEquals(t, data, "12345", "int json marshal")
+
+	data, err = json.Marshal(NewStrictFloat(0, false))
+	maybePanic(err)
+	assertJSONEquals(t, data, "0", "null float json marshal")
+
+	// text stays lenient, since it has no types
+	var i StrictInt32
+	err = i.UnmarshalText([]byte("12345"))
+	maybePanic(err)
+	assertInt32(t, i.Int32, "text unmarshal")
+}
+
+// assertStrictRejects checks that each input is rejected with an error naming the JSON type needed.
+// Inputs with whitespace around them are passed to UnmarshalJSON directly, since encoding/json removes it.
+func assertStrictRejects(t *testing.T, v json.Unmarshaler, need string, inputs ...string) {
+	t.Helper()
+	for _, input := range inputs {
+		err := v.UnmarshalJSON([]byte(input))
+		if strings.TrimSpace(input) == input {
+			err = json.Unmarshal([]byte(input), v)
+		}
+		if err == nil {
+			t.Errorf("%T from %q: expected error", v, input)
+			continue
+		}
+		if !strings.Contains(err.Error(), "(need "+need+")") {
+			t.Errorf("%T from %q: error %q doesn't explain the type needed", v, input, err)
+		}
+		var typeError *json.UnmarshalTypeError
+		if strings.TrimSpace(input) == input && !errors.As(err, &typeError) {
+			t.Errorf("%T from %q: expected wrapped *json.UnmarshalTypeError, not %v", v, input, err)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 	"time"
 )
@@ -56,14 +57,33 @@ func (t Time) ValueOrZero() time.Time {
 	return t.Time
 }
 
+// OrElse returns the inner value if valid and non-zero, otherwise v.
+func (t Time) OrElse(v time.Time) time.Time {
+	if t.IsZero() {
+		return v
+	}
+	return t.Time
+}
+
+// OrElseGet returns the inner value if valid and non-zero, otherwise the result of calling f.
+// f is only called if this Time is null or zero.
+func (t Time) OrElseGet(f func() time.Time) time.Time {
+	if t.IsZero() {
+		return f()
+	}
+	return t.Time
+}
+
 // MarshalJSON implements json.Marshaler.
 // It will encode the zero value of time.Time
 // if this time is invalid.
 func (t Time) MarshalJSON() ([]byte, error) {
-	if !t.Valid {
-		return (time.Time{}).MarshalJSON()
-	}
-	return t.Time.MarshalJSON()
+	return t.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of t to dst, the same as MarshalJSON.
+func (t Time) AppendJSON(dst []byte) ([]byte, error) {
+	return appendTimeJSON(dst, t.ValueOrZero())
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
@@ -75,6 +95,15 @@ func (t *Time) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
+	if len(data) > 0 && data[0] == '"' {
+		var v time.Time
+		if err := v.UnmarshalJSON(data); err == nil {
+			t.Time = v
+			t.Valid = !v.IsZero()
+			return nil
+		}
+	}
+
 	if err := json.Unmarshal(data, &t.Time); err != nil {
 		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
 	}
@@ -86,11 +115,13 @@ func (t *Time) UnmarshalJSON(data []byte) error {
 // MarshalText implements encoding.TextMarshaler.
 // It will encode to an empty time.Time if invalid.
 func (t Time) MarshalText() ([]byte, error) {
-	ti := t.Time
-	if !t.Valid {
-		ti = time.Time{}
-	}
-	return ti.MarshalText()
+	return t.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append an empty time.Time if invalid.
+func (t Time) AppendText(dst []byte) ([]byte, error) {
+	return t.ValueOrZero().AppendText(dst)
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
@@ -110,6 +141,93 @@ func (t *Time) UnmarshalText(text []byte) error {
 	return nil
 }
 
+// MarshalXML implements xml.Marshaler.
+// It will encode null as the zero value; see XMLEmpty, XMLOmit and XMLNil for other encodings.
+func (t Time) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, xmlNullZero, t.IsZero(), t)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// It will unmarshal to a null Time if the element is empty or has xsi:nil="true".
+func (t *Time) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, t)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will encode null as the zero value; see XMLEmpty and XMLOmit for other encodings.
+func (t Time) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, xmlNullZero, t.IsZero(), t)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+// It will unmarshal to a null Time if the attribute is empty.
+func (t *Time) UnmarshalXMLAttr(attr xml.Attr) error {
+	return t.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It encodes a version byte and a validity byte; valid values are followed by the encoding of time.Time.MarshalBinary, which includes the zone offset.
+func (t Time) MarshalBinary() ([]byte, error) {
+	if !t.Valid {
+		return binaryNull(), nil
+	}
+	data, err := t.Time.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("zero: couldn't marshal binary: %w", err)
+	}
+	return append(binaryHeader(len(data)), data...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// It decodes the encoding produced by MarshalBinary.
+func (t *Time) UnmarshalBinary(data []byte) error {
+	value, valid, err := readBinary(data, -1)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		*t = NewTime(time.Time{}, false)
+		return nil
+	}
+	var v time.Time
+	if err := v.UnmarshalBinary(value); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal binary: %w", err)
+	}
+	*t = NewTime(v, true)
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using MarshalBinary.
+func (t Time) GobEncode() ([]byte, error) {
+	return t.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using UnmarshalBinary.
+func (t *Time) GobDecode(data []byte) error {
+	return t.UnmarshalBinary(data)
+}
+
+// MarshalYAML implements yaml.Marshaler from gopkg.in/yaml.v3 and compatible packages.
+// It will encode the zero value if this Time is null.
+func (t Time) MarshalYAML() (any, error) {
+	return t.ValueOrZero(), nil
+}
+
+// UnmarshalYAML implements the unmarshaler interface of gopkg.in/yaml.v2, which gopkg.in/yaml.v3 also supports.
+// It supports timestamp and null input.
+func (t *Time) UnmarshalYAML(unmarshal func(any) error) error {
+	var v *time.Time
+	if err := unmarshal(&v); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal YAML: %w", err)
+	}
+	if v == nil {
+		*t = NewTime(time.Time{}, false)
+		return nil
+	}
+	*t = TimeFrom(*v)
+	return nil
+}
+
 // SetValid changes this Time's value and
 // sets it to be non-null.
 func (t *Time) SetValid(v time.Time) {
@@ -138,9 +256,28 @@ func (t Time) Equal(other Time) bool {
 	return t.ValueOrZero().Equal(other.ValueOrZero())
 }
 
+// Compare returns an integer comparing two Times, for use with slices.SortFunc and similar.
+// The result is -1 if t < other, 0 if t == other, and +1 if t > other.
+// Null is considered equal to the zero value, consistent with Equal.
+// Times are ordered by the instant they represent.
+func (t Time) Compare(other Time) int {
+	return t.ValueOrZero().Compare(other.ValueOrZero())
+}
+
 // ExactEqual returns true if both Time objects are equal or both are either null or zero.
 // ExactEqual returns false for times that are in different locations or
 // have a different monotonic clock reading.
 func (t Time) ExactEqual(other Time) bool {
 	return t.ValueOrZero() == other.ValueOrZero()
 }
+
+// appendTimeJSON appends t to b as an RFC 3339 string, the same as time.Time's MarshalJSON.
+func appendTimeJSON(b []byte, t time.Time) ([]byte, error) {
+	out, err := t.AppendText(append(b, '"'))
+	if err != nil {
+		// for the error message of MarshalJSON
+		_, err = t.MarshalJSON()
+		return b, err
+	}
+	return append(out, '"'), nil
+}
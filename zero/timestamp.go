@@ -3,8 +3,9 @@ package zero
 import (
 	"database/sql"
 	"database/sql/driver"
-	"encoding/json"
+	"encoding/xml"
 	"fmt"
+	"strconv"
 	"time"
 )
 
@@ -56,14 +57,36 @@ func (t Timestamp) ValueOrZero() time.Time {
 	return t.Time
 }
 
+// OrElse returns the inner value if valid and non-zero, otherwise v.
+func (t Timestamp) OrElse(v time.Time) time.Time {
+	if t.IsZero() {
+		return v
+	}
+	return t.Time
+}
+
+// OrElseGet returns the inner value if valid and non-zero, otherwise the result of calling f.
+// f is only called if this Timestamp is null or zero.
+func (t Timestamp) OrElseGet(f func() time.Time) time.Time {
+	if t.IsZero() {
+		return f()
+	}
+	return t.Time
+}
+
 // MarshalJSON implements json.Marshaler.
 // It will encode the zero value of time.Time
 // if this time is invalid.
 func (t Timestamp) MarshalJSON() ([]byte, error) {
+	return t.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of t to dst, the same as MarshalJSON.
+func (t Timestamp) AppendJSON(dst []byte) ([]byte, error) {
 	if !t.Valid {
-		return []byte("0"), nil
+		return append(dst, '0'), nil
 	}
-	return json.Marshal(t.Time.UnixNano() / int64(time.Millisecond))
+	return strconv.AppendInt(dst, t.Time.UnixNano()/int64(time.Millisecond), 10), nil
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
@@ -75,12 +98,15 @@ func (t *Timestamp) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
-	var value Int64
-	err := value.UnmarshalJSON(data)
-	if err != nil {
-		return err
+	ms, ok := parseJSONInt(data, 64)
+	if !ok {
+		var value Int64
+		if err := value.UnmarshalJSON(data); err != nil {
+			return err
+		}
+		ms = value.Int64
 	}
-	t.Time = time.UnixMilli(0).UTC().Add(time.Duration(value.Int64 * int64(time.Millisecond)))
+	t.Time = time.UnixMilli(0).UTC().Add(time.Duration(ms * int64(time.Millisecond)))
 	t.Valid = !t.Time.IsZero()
 	return nil
 }
@@ -88,11 +114,16 @@ func (t *Timestamp) UnmarshalJSON(data []byte) error {
 // MarshalText implements encoding.TextMarshaler.
 // It will encode to an empty time.Time if invalid.
 func (t Timestamp) MarshalText() ([]byte, error) {
+	return t.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append 0 if invalid, otherwise milliseconds since the Unix epoch.
+func (t Timestamp) AppendText(dst []byte) ([]byte, error) {
 	if !t.Valid {
-		return []byte("0"), nil
+		return append(dst, '0'), nil
 	}
-	n := Int64From(t.Time.UnixMilli())
-	return n.MarshalText()
+	return strconv.AppendInt(dst, t.Time.UnixMilli(), 10), nil
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
@@ -113,6 +144,96 @@ func (t *Timestamp) UnmarshalText(text []byte) error {
 	return nil
 }
 
+// MarshalXML implements xml.Marshaler.
+// It will encode null as the zero value; see XMLEmpty, XMLOmit and XMLNil for other encodings.
+func (t Timestamp) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, xmlNullZero, t.IsZero(), t)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// It will unmarshal to a null Timestamp if the element is empty or has xsi:nil="true".
+func (t *Timestamp) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, t)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will encode null as the zero value; see XMLEmpty and XMLOmit for other encodings.
+func (t Timestamp) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, xmlNullZero, t.IsZero(), t)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+// It will unmarshal to a null Timestamp if the attribute is empty.
+func (t *Timestamp) UnmarshalXMLAttr(attr xml.Attr) error {
+	return t.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It encodes a version byte and a validity byte; valid values are followed by the encoding of time.Time.MarshalBinary, which includes the zone offset.
+func (t Timestamp) MarshalBinary() ([]byte, error) {
+	if !t.Valid {
+		return binaryNull(), nil
+	}
+	data, err := t.Time.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("zero: couldn't marshal binary: %w", err)
+	}
+	return append(binaryHeader(len(data)), data...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// It decodes the encoding produced by MarshalBinary.
+func (t *Timestamp) UnmarshalBinary(data []byte) error {
+	value, valid, err := readBinary(data, -1)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		*t = NewTimestamp(time.Time{}, false)
+		return nil
+	}
+	var v time.Time
+	if err := v.UnmarshalBinary(value); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal binary: %w", err)
+	}
+	*t = NewTimestamp(v, true)
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using MarshalBinary.
+func (t Timestamp) GobEncode() ([]byte, error) {
+	return t.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using UnmarshalBinary.
+func (t *Timestamp) GobDecode(data []byte) error {
+	return t.UnmarshalBinary(data)
+}
+
+// MarshalYAML implements yaml.Marshaler from gopkg.in/yaml.v3 and compatible packages.
+// It will encode 0 if this Timestamp is null.
+func (t Timestamp) MarshalYAML() (any, error) {
+	if !t.Valid {
+		return int64(0), nil
+	}
+	return t.Time.UnixMilli(), nil
+}
+
+// UnmarshalYAML implements the unmarshaler interface of gopkg.in/yaml.v2, which gopkg.in/yaml.v3 also supports.
+// It supports integer millisecond and null input.
+func (t *Timestamp) UnmarshalYAML(unmarshal func(any) error) error {
+	var v *int64
+	if err := unmarshal(&v); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal YAML: %w", err)
+	}
+	if v == nil {
+		*t = NewTimestamp(time.Time{}, false)
+		return nil
+	}
+	*t = TimestampFrom(time.UnixMilli(*v).UTC())
+	return nil
+}
+
 // SetValid changes this Timestamp's value and
 // sets it to be non-null.
 func (t *Timestamp) SetValid(v time.Time) {
@@ -141,6 +262,14 @@ func (t Timestamp) Equal(other Timestamp) bool {
 	return t.ValueOrZero().Equal(other.ValueOrZero())
 }
 
+// Compare returns an integer comparing two Timestamps, for use with slices.SortFunc and similar.
+// The result is -1 if t < other, 0 if t == other, and +1 if t > other.
+// Null is considered equal to the zero value, consistent with Equal.
+// Times are ordered by the instant they represent.
+func (t Timestamp) Compare(other Timestamp) int {
+	return t.ValueOrZero().Compare(other.ValueOrZero())
+}
+
 // ExactEqual returns true if both Timestamp objects are equal or both are either null or zero.
 // ExactEqual returns false for times that are in different locations or
 // have a different monotonic clock reading.
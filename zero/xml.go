@@ -0,0 +1,494 @@
+package zero
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"reflect"
+)
+
+// xmlNullStyle selects how null and zero values are encoded in XML.
+type xmlNullStyle int
+
+const (
+	// xmlNullZero encodes null as the zero value, like MarshalText.
+	xmlNullZero xmlNullStyle = iota
+	// xmlNullEmpty encodes null as an empty element, <v></v>, or an empty attribute.
+	xmlNullEmpty
+	// xmlNullOmit omits null elements and attributes.
+	xmlNullOmit
+	// xmlNullNil encodes null as an empty element with xsi:nil="true".
+	// Null attributes are omitted.
+	xmlNullNil
+)
+
+// xmlValue is a nullable type that can be wrapped by XMLEmpty, XMLOmit and XMLNil.
+// IsZero reports whether the value is null or zero.
+// The pointer type must also implement the decoding methods, as all of this package's types do.
+type xmlValue interface {
+	IsZero() bool
+	driver.Valuer
+	encoding.TextMarshaler
+	encoding.BinaryMarshaler
+	GobEncode() ([]byte, error)
+	MarshalYAML() (any, error)
+}
+
+// XMLEmpty wraps a type, such as String or Int64, to encode null and zero values
+// as empty XML elements and attributes instead of the zero value.
+// Everything else, including SQL, JSON, text, binary, gob and YAML encoding and decoding,
+// is forwarded to the wrapped value, so XMLEmpty[T] can replace T in any struct.
+type XMLEmpty[T xmlValue] struct {
+	V T
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element if null or zero.
+func (x XMLEmpty[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, xmlNullEmpty, x.V.IsZero(), x.V)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (x *XMLEmpty[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return d.DecodeElement(&x.V, &start)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will encode an empty attribute if null or zero.
+func (x XMLEmpty[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, xmlNullEmpty, x.V.IsZero(), x.V)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (x *XMLEmpty[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	return unmarshalXMLAttr(attr, &x.V)
+}
+
+// IsZero reports whether the wrapped value is zero, for omitzero support.
+func (x XMLEmpty[T]) IsZero() bool {
+	return x.V.IsZero()
+}
+
+// Value implements the driver Valuer interface.
+func (x XMLEmpty[T]) Value() (driver.Value, error) {
+	return x.V.Value()
+}
+
+// Scan implements the Scanner interface.
+func (x *XMLEmpty[T]) Scan(value any) error {
+	s, err := wrappedAs[sql.Scanner](&x.V)
+	if err != nil {
+		return err
+	}
+	return s.Scan(value)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (x XMLEmpty[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(x.V)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (x *XMLEmpty[T]) UnmarshalJSON(data []byte) error {
+	u, err := wrappedAs[json.Unmarshaler](&x.V)
+	if err != nil {
+		return err
+	}
+	return u.UnmarshalJSON(data)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (x XMLEmpty[T]) MarshalText() ([]byte, error) {
+	return x.V.MarshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (x *XMLEmpty[T]) UnmarshalText(text []byte) error {
+	u, err := wrappedAs[encoding.TextUnmarshaler](&x.V)
+	if err != nil {
+		return err
+	}
+	return u.UnmarshalText(text)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (x XMLEmpty[T]) MarshalBinary() ([]byte, error) {
+	return x.V.MarshalBinary()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (x *XMLEmpty[T]) UnmarshalBinary(data []byte) error {
+	u, err := wrappedAs[encoding.BinaryUnmarshaler](&x.V)
+	if err != nil {
+		return err
+	}
+	return u.UnmarshalBinary(data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (x XMLEmpty[T]) GobEncode() ([]byte, error) {
+	return x.V.GobEncode()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (x *XMLEmpty[T]) GobDecode(data []byte) error {
+	d, err := wrappedAs[gobDecoder](&x.V)
+	if err != nil {
+		return err
+	}
+	return d.GobDecode(data)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (x XMLEmpty[T]) MarshalYAML() (any, error) {
+	return x.V.MarshalYAML()
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (x *XMLEmpty[T]) UnmarshalYAML(unmarshal func(any) error) error {
+	u, err := wrappedAs[yamlUnmarshaler](&x.V)
+	if err != nil {
+		return err
+	}
+	return u.UnmarshalYAML(unmarshal)
+}
+
+// XMLOmit wraps a type, such as String or Int64, to omit XML elements and attributes
+// with null or zero values instead of encoding the zero value.
+// Everything else, including SQL, JSON, text, binary, gob and YAML encoding and decoding,
+// is forwarded to the wrapped value, so XMLOmit[T] can replace T in any struct.
+type XMLOmit[T xmlValue] struct {
+	V T
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will omit the element if null or zero.
+func (x XMLOmit[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, xmlNullOmit, x.V.IsZero(), x.V)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (x *XMLOmit[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return d.DecodeElement(&x.V, &start)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will omit the attribute if null or zero.
+func (x XMLOmit[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, xmlNullOmit, x.V.IsZero(), x.V)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (x *XMLOmit[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	return unmarshalXMLAttr(attr, &x.V)
+}
+
+// IsZero reports whether the wrapped value is zero, for omitzero support.
+func (x XMLOmit[T]) IsZero() bool {
+	return x.V.IsZero()
+}
+
+// Value implements the driver Valuer interface.
+func (x XMLOmit[T]) Value() (driver.Value, error) {
+	return x.V.Value()
+}
+
+// Scan implements the Scanner interface.
+func (x *XMLOmit[T]) Scan(value any) error {
+	s, err := wrappedAs[sql.Scanner](&x.V)
+	if err != nil {
+		return err
+	}
+	return s.Scan(value)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (x XMLOmit[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(x.V)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (x *XMLOmit[T]) UnmarshalJSON(data []byte) error {
+	u, err := wrappedAs[json.Unmarshaler](&x.V)
+	if err != nil {
+		return err
+	}
+	return u.UnmarshalJSON(data)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (x XMLOmit[T]) MarshalText() ([]byte, error) {
+	return x.V.MarshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (x *XMLOmit[T]) UnmarshalText(text []byte) error {
+	u, err := wrappedAs[encoding.TextUnmarshaler](&x.V)
+	if err != nil {
+		return err
+	}
+	return u.UnmarshalText(text)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (x XMLOmit[T]) MarshalBinary() ([]byte, error) {
+	return x.V.MarshalBinary()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (x *XMLOmit[T]) UnmarshalBinary(data []byte) error {
+	u, err := wrappedAs[encoding.BinaryUnmarshaler](&x.V)
+	if err != nil {
+		return err
+	}
+	return u.UnmarshalBinary(data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (x XMLOmit[T]) GobEncode() ([]byte, error) {
+	return x.V.GobEncode()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (x *XMLOmit[T]) GobDecode(data []byte) error {
+	d, err := wrappedAs[gobDecoder](&x.V)
+	if err != nil {
+		return err
+	}
+	return d.GobDecode(data)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (x XMLOmit[T]) MarshalYAML() (any, error) {
+	return x.V.MarshalYAML()
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (x *XMLOmit[T]) UnmarshalYAML(unmarshal func(any) error) error {
+	u, err := wrappedAs[yamlUnmarshaler](&x.V)
+	if err != nil {
+		return err
+	}
+	return u.UnmarshalYAML(unmarshal)
+}
+
+// XMLNil wraps a type, such as String or Int64, to encode null and zero values as empty XML elements
+// with xsi:nil="true", as used by SOAP and XML Schema.
+// Null and zero attributes are omitted.
+// Everything else, including SQL, JSON, text, binary, gob and YAML encoding and decoding,
+// is forwarded to the wrapped value, so XMLNil[T] can replace T in any struct.
+type XMLNil[T xmlValue] struct {
+	V T
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an xsi:nil element if null or zero.
+func (x XMLNil[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, xmlNullNil, x.V.IsZero(), x.V)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (x *XMLNil[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return d.DecodeElement(&x.V, &start)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will omit the attribute if null or zero.
+func (x XMLNil[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, xmlNullNil, x.V.IsZero(), x.V)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (x *XMLNil[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	return unmarshalXMLAttr(attr, &x.V)
+}
+
+// IsZero reports whether the wrapped value is zero, for omitzero support.
+func (x XMLNil[T]) IsZero() bool {
+	return x.V.IsZero()
+}
+
+// Value implements the driver Valuer interface.
+func (x XMLNil[T]) Value() (driver.Value, error) {
+	return x.V.Value()
+}
+
+// Scan implements the Scanner interface.
+func (x *XMLNil[T]) Scan(value any) error {
+	s, err := wrappedAs[sql.Scanner](&x.V)
+	if err != nil {
+		return err
+	}
+	return s.Scan(value)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (x XMLNil[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(x.V)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (x *XMLNil[T]) UnmarshalJSON(data []byte) error {
+	u, err := wrappedAs[json.Unmarshaler](&x.V)
+	if err != nil {
+		return err
+	}
+	return u.UnmarshalJSON(data)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (x XMLNil[T]) MarshalText() ([]byte, error) {
+	return x.V.MarshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (x *XMLNil[T]) UnmarshalText(text []byte) error {
+	u, err := wrappedAs[encoding.TextUnmarshaler](&x.V)
+	if err != nil {
+		return err
+	}
+	return u.UnmarshalText(text)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (x XMLNil[T]) MarshalBinary() ([]byte, error) {
+	return x.V.MarshalBinary()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (x *XMLNil[T]) UnmarshalBinary(data []byte) error {
+	u, err := wrappedAs[encoding.BinaryUnmarshaler](&x.V)
+	if err != nil {
+		return err
+	}
+	return u.UnmarshalBinary(data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (x XMLNil[T]) GobEncode() ([]byte, error) {
+	return x.V.GobEncode()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (x *XMLNil[T]) GobDecode(data []byte) error {
+	d, err := wrappedAs[gobDecoder](&x.V)
+	if err != nil {
+		return err
+	}
+	return d.GobDecode(data)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (x XMLNil[T]) MarshalYAML() (any, error) {
+	return x.V.MarshalYAML()
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (x *XMLNil[T]) UnmarshalYAML(unmarshal func(any) error) error {
+	u, err := wrappedAs[yamlUnmarshaler](&x.V)
+	if err != nil {
+		return err
+	}
+	return u.UnmarshalYAML(unmarshal)
+}
+
+// gobDecoder and yamlUnmarshaler are the decoding interfaces of encoding/gob and gopkg.in/yaml.v2 and v3.
+type (
+	gobDecoder interface {
+		GobDecode([]byte) error
+	}
+	yamlUnmarshaler interface {
+		UnmarshalYAML(unmarshal func(any) error) error
+	}
+)
+
+// wrappedAs returns v, a pointer to a wrapped value, as the decoding interface I.
+// It returns an error if the wrapped type doesn't implement I.
+func wrappedAs[I any](v any) (I, error) {
+	i, ok := v.(I)
+	if !ok {
+		return i, fmt.Errorf("zero: %T doesn't implement %v", v, reflect.TypeFor[I]())
+	}
+	return i, nil
+}
+
+// xsiNamespace is the XML Schema instance namespace, which defines the nil attribute.
+const xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+
+// marshalXML encodes the text of v as the element start, or null according to style.
+func marshalXML(e *xml.Encoder, start xml.StartElement, style xmlNullStyle, zero bool, v encoding.TextMarshaler) error {
+	if !zero || style == xmlNullZero {
+		text, err := v.MarshalText()
+		if err != nil {
+			return err
+		}
+		return e.EncodeElement(string(text), start)
+	}
+
+	switch style {
+	case xmlNullOmit:
+		return nil
+	case xmlNullNil:
+		// copy, so the caller's attributes aren't modified
+		start.Attr = append(start.Attr[:len(start.Attr):len(start.Attr)],
+			xml.Attr{Name: xml.Name{Local: "xmlns:xsi"}, Value: xsiNamespace},
+			xml.Attr{Name: xml.Name{Local: "xsi:nil"}, Value: "true"})
+	}
+	return e.EncodeElement("", start)
+}
+
+// marshalXMLAttr encodes the text of v as the attribute name, or null according to style.
+func marshalXMLAttr(name xml.Name, style xmlNullStyle, zero bool, v encoding.TextMarshaler) (xml.Attr, error) {
+	if zero && style != xmlNullZero {
+		if style != xmlNullEmpty {
+			return xml.Attr{}, nil
+		}
+		return xml.Attr{Name: name, Value: ""}, nil
+	}
+	text, err := v.MarshalText()
+	if err != nil {
+		return xml.Attr{}, err
+	}
+	return xml.Attr{Name: name, Value: string(text)}, nil
+}
+
+// unmarshalXML decodes the element start into v using its text.
+// Elements with xsi:nil="true" are decoded as null.
+func unmarshalXML(d *xml.Decoder, start xml.StartElement, v encoding.TextUnmarshaler) error {
+	for _, attr := range start.Attr {
+		if isXSINil(attr) {
+			if err := v.UnmarshalText(nil); err != nil {
+				return err
+			}
+			return d.Skip()
+		}
+	}
+	var text string
+	if err := d.DecodeElement(&text, &start); err != nil {
+		return err
+	}
+	return v.UnmarshalText([]byte(text))
+}
+
+// unmarshalXMLAttr decodes attr into v, which should implement xml.UnmarshalerAttr or encoding.TextUnmarshaler.
+func unmarshalXMLAttr(attr xml.Attr, v any) error {
+	switch u := v.(type) {
+	case xml.UnmarshalerAttr:
+		return u.UnmarshalXMLAttr(attr)
+	case encoding.TextUnmarshaler:
+		return u.UnmarshalText([]byte(attr.Value))
+	}
+	return fmt.Errorf("zero: couldn't unmarshal XML attribute %s into %T", attr.Name.Local, v)
+}
+
+// isXSINil reports whether attr is xsi:nil="true".
+// The xsi prefix is accepted even if it isn't declared.
+func isXSINil(attr xml.Attr) bool {
+	if attr.Name.Local != "nil" || (attr.Name.Space != xsiNamespace && attr.Name.Space != "xsi") {
+		return false
+	}
+	return attr.Value == "true" || attr.Value == "1"
+}
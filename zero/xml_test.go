@@ -0,0 +1,275 @@
+package zero
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"testing"
+	"time"
+)
+
+type xmlRecord struct {
+	XMLName   xml.Name    `xml:"record"`
+	ID        Int64       `xml:"id,attr"`
+	Rank      Int32       `xml:"rank"`
+	Name      String      `xml:"name"`
+	Score     Float       `xml:"score"`
+	Active    Bool        `xml:"active"`
+	Lenient   LenientBool `xml:"lenient"`
+	Created   Time        `xml:"created"`
+	Timestamp Timestamp   `xml:"timestamp"`
+}
+
+type xmlEmptyRecord struct {
+	XMLName   xml.Name              `xml:"record"`
+	ID        XMLEmpty[Int64]       `xml:"id,attr"`
+	Rank      XMLEmpty[Int32]       `xml:"rank"`
+	Name      XMLEmpty[String]      `xml:"name"`
+	Score     XMLEmpty[Float]       `xml:"score"`
+	Active    XMLEmpty[Bool]        `xml:"active"`
+	Lenient   XMLEmpty[LenientBool] `xml:"lenient"`
+	Created   XMLEmpty[Time]        `xml:"created"`
+	Timestamp XMLEmpty[Timestamp]   `xml:"timestamp"`
+}
+
+type xmlOmitRecord struct {
+	XMLName   xml.Name             `xml:"record"`
+	ID        XMLOmit[Int64]       `xml:"id,attr"`
+	Rank      XMLOmit[Int32]       `xml:"rank"`
+	Name      XMLOmit[String]      `xml:"name"`
+	Score     XMLOmit[Float]       `xml:"score"`
+	Active    XMLOmit[Bool]        `xml:"active"`
+	Lenient   XMLOmit[LenientBool] `xml:"lenient"`
+	Created   XMLOmit[Time]        `xml:"created"`
+	Timestamp XMLOmit[Timestamp]   `xml:"timestamp"`
+}
+
+type xmlNilRecord struct {
+	XMLName   xml.Name            `xml:"record"`
+	ID        XMLNil[Int64]       `xml:"id,attr"`
+	Rank      XMLNil[Int32]       `xml:"rank"`
+	Name      XMLNil[String]      `xml:"name"`
+	Score     XMLNil[Float]       `xml:"score"`
+	Active    XMLNil[Bool]        `xml:"active"`
+	Lenient   XMLNil[LenientBool] `xml:"lenient"`
+	Created   XMLNil[Time]        `xml:"created"`
+	Timestamp XMLNil[Timestamp]   `xml:"timestamp"`
+}
+
+func TestMarshalXML(t *testing.T) {
+	created := time.Date(2012, 12, 21, 21, 21, 21, 0, time.UTC)
+	rec := xmlRecord{
+		ID:        Int64From(12345),
+		Rank:      Int32From(1),
+		Name:      StringFrom("test"),
+		Score:     FloatFrom(1.2345),
+		Active:    BoolFrom(true),
+		Lenient:   LenientBoolFrom(true),
+		Created:   TimeFrom(created),
+		Timestamp: TimestampFrom(time.UnixMilli(1356124881000).UTC()),
+	}
+	want := `<record id="12345"><rank>1</rank><name>test</name><score>1.2345</score><active>true</active>` +
+		`<lenient>true</lenient><created>2012-12-21T21:21:21Z</created><timestamp>1356124881000</timestamp></record>`
+	data, err := xml.Marshal(rec)
+	maybePanic(err)
+	assertXML(t, data, want, "valid record")
+
+	var got xmlRecord
+	err = xml.Unmarshal(data, &got)
+	maybePanic(err)
+	got.XMLName = xml.Name{}
+	if got != rec {
+		t.Errorf("round trip: got %+v, want %+v", got, rec)
+	}
+
+	// the wrappers only change how null and zero values are encoded
+	for _, wrapped := range []any{
+		xmlEmptyRecord{ID: XMLEmpty[Int64]{rec.ID}, Rank: XMLEmpty[Int32]{rec.Rank}, Name: XMLEmpty[String]{rec.Name},
+			Score: XMLEmpty[Float]{rec.Score}, Active: XMLEmpty[Bool]{rec.Active}, Lenient: XMLEmpty[LenientBool]{rec.Lenient},
+			Created: XMLEmpty[Time]{rec.Created}, Timestamp: XMLEmpty[Timestamp]{rec.Timestamp}},
+		xmlOmitRecord{ID: XMLOmit[Int64]{rec.ID}, Rank: XMLOmit[Int32]{rec.Rank}, Name: XMLOmit[String]{rec.Name},
+			Score: XMLOmit[Float]{rec.Score}, Active: XMLOmit[Bool]{rec.Active}, Lenient: XMLOmit[LenientBool]{rec.Lenient},
+			Created: XMLOmit[Time]{rec.Created}, Timestamp: XMLOmit[Timestamp]{rec.Timestamp}},
+		xmlNilRecord{ID: XMLNil[Int64]{rec.ID}, Rank: XMLNil[Int32]{rec.Rank}, Name: XMLNil[String]{rec.Name},
+			Score: XMLNil[Float]{rec.Score}, Active: XMLNil[Bool]{rec.Active}, Lenient: XMLNil[LenientBool]{rec.Lenient},
+			Created: XMLNil[Time]{rec.Created}, Timestamp: XMLNil[Timestamp]{rec.Timestamp}},
+	} {
+		data, err := xml.Marshal(wrapped)
+		maybePanic(err)
+		assertXML(t, data, want, fmt.Sprintf("valid %T", wrapped))
+	}
+}
+
+func TestMarshalXMLNull(t *testing.T) {
+	tests := []struct {
+		name   string
+		rec    any // record with null and zero values
+		filled any // pointer to a record to decode into, or nil if it would be left unchanged
+		want   string
+	}{
+		// valid zero values are encoded like null
+		{"zero", xmlRecord{Rank: NewInt32(0, true)},
+			&xmlRecord{ID: Int64From(1), Rank: Int32From(1), Name: StringFrom("test"), Created: TimeFrom(time.Now())},
+			`<record id="0"><rank>0</rank><name></name><score>0</score><active>false</active>` +
+				`<lenient>false</lenient><created>0001-01-01T00:00:00Z</created><timestamp>0</timestamp></record>`},
+		{"empty", xmlEmptyRecord{Rank: XMLEmpty[Int32]{NewInt32(0, true)}},
+			&xmlEmptyRecord{ID: XMLEmpty[Int64]{Int64From(1)}, Rank: XMLEmpty[Int32]{Int32From(1)}, Name: XMLEmpty[String]{StringFrom("test")}},
+			`<record id=""><rank></rank><name></name><score></score><active></active>` +
+				`<lenient></lenient><created></created><timestamp></timestamp></record>`},
+		{"omit", xmlOmitRecord{Rank: XMLOmit[Int32]{NewInt32(0, true)}}, nil, `<record></record>`},
+		{"nil", xmlNilRecord{Rank: XMLNil[Int32]{NewInt32(0, true)}},
+			&xmlNilRecord{Rank: XMLNil[Int32]{Int32From(1)}, Name: XMLNil[String]{StringFrom("test")}},
+			`<record>` +
+				`<rank xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:nil="true"></rank>` +
+				`<name xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:nil="true"></name>` +
+				`<score xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:nil="true"></score>` +
+				`<active xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:nil="true"></active>` +
+				`<lenient xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:nil="true"></lenient>` +
+				`<created xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:nil="true"></created>` +
+				`<timestamp xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:nil="true"></timestamp></record>`},
+	}
+	for _, test := range tests {
+		data, err := xml.Marshal(test.rec)
+		maybePanic(err)
+		assertXML(t, data, test.want, test.name+" null record")
+
+		if test.filled == nil {
+			continue
+		}
+		// every field should be set to null, so it encodes the same as the null record
+		err = xml.Unmarshal(data, test.filled)
+		maybePanic(err)
+		data, err = xml.Marshal(test.filled)
+		maybePanic(err)
+		assertXML(t, data, test.want, test.name+" unmarshaled record")
+	}
+}
+
+func TestXMLWrappers(t *testing.T) {
+	type record struct {
+		XMLName xml.Name         `xml:"record"`
+		ID      XMLOmit[Int64]   `xml:"id,attr"`
+		Name    XMLNil[String]   `xml:"name"`
+		Note    XMLEmpty[String] `xml:"note"`
+		Score   Float            `xml:"score"`
+	}
+
+	// each field uses its own convention
+	data, err := xml.Marshal(record{})
+	maybePanic(err)
+	assertXML(t, data, `<record><name xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:nil="true"></name>`+
+		`<note></note><score>0</score></record>`, "null wrapped record")
+
+	var got record
+	err = xml.Unmarshal([]byte(`<record id="12345"><name>test</name></record>`), &got)
+	maybePanic(err)
+	assertInt64(t, got.ID.V, "wrapped attribute")
+	assertStr(t, got.Name.V, "wrapped element")
+
+	// JSON is the same as for the wrapped type
+	data, err = json.Marshal(got)
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"XMLName":{"Space":"","Local":"record"},"ID":12345,"Name":"test","Note":"","Score":0}`, "wrapped record json")
+	got = record{}
+	err = json.Unmarshal([]byte(`{"ID":null,"Name":"test"}`), &got)
+	maybePanic(err)
+	assertNullInt64(t, got.ID.V, "wrapped json null")
+	assertStr(t, got.Name.V, "wrapped json")
+}
+
+func TestXMLWrapperForwarding(t *testing.T) {
+	type record struct {
+		ID   XMLOmit[Int64]
+		Name XMLNil[String]
+	}
+	rec := record{ID: XMLOmit[Int64]{Int64From(12345)}, Name: XMLNil[String]{StringFrom("test")}}
+
+	// SQL
+	v, err := rec.Name.Value()
+	maybePanic(err)
+	if v != "test" {
+		t.Errorf("Value(): got %v, want test", v)
+	}
+	var name XMLNil[String]
+	maybePanic(name.Scan("test"))
+	assertStr(t, name.V, "scanned wrapper")
+	var id XMLOmit[Int64]
+	maybePanic(id.Scan(nil))
+	assertNullInt64(t, id.V, "scanned null wrapper")
+
+	// text
+	data, err := rec.ID.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "12345", "wrapper text marshal")
+	maybePanic(id.UnmarshalText(data))
+	assertInt64(t, id.V, "wrapper text unmarshal")
+
+	// binary and gob
+	data, err = rec.Name.MarshalBinary()
+	maybePanic(err)
+	name = XMLNil[String]{}
+	maybePanic(name.UnmarshalBinary(data))
+	assertStr(t, name.V, "wrapper binary unmarshal")
+	var buf bytes.Buffer
+	maybePanic(gob.NewEncoder(&buf).Encode(rec))
+	var got record
+	maybePanic(gob.NewDecoder(&buf).Decode(&got))
+	if got != rec {
+		t.Errorf("gob round trip: got %+v, want %+v", got, rec)
+	}
+
+	// YAML
+	y, err := rec.ID.MarshalYAML()
+	maybePanic(err)
+	if y != int64(12345) {
+		t.Errorf("MarshalYAML(): got %#v, want 12345", y)
+	}
+	id = XMLOmit[Int64]{}
+	maybePanic(unmarshalYAML(&id, `12345`))
+	assertInt64(t, id.V, "wrapper yaml unmarshal")
+
+	// omitzero uses the wrapped value
+	if rec.ID.IsZero() || !(XMLOmit[Int64]{}).IsZero() {
+		t.Error("IsZero() of wrapper returned the wrong result")
+	}
+}
+
+func TestUnmarshalXMLNil(t *testing.T) {
+	data := []byte(`<record><rank xsi:nil="true"></rank><name xsi:nil="1"/>` +
+		`<score xmlns:x="http://www.w3.org/2001/XMLSchema-instance" x:nil="true">1</score></record>`)
+	rec := xmlRecord{Rank: Int32From(1), Name: StringFrom("test"), Score: FloatFrom(1)}
+	err := xml.Unmarshal(data, &rec)
+	maybePanic(err)
+	assertNullInt32(t, rec.Rank, "xsi:nil rank")
+	assertNullStr(t, rec.Name, "xsi:nil name")
+	assertNullFloat(t, rec.Score, "xsi:nil score")
+}
+
+func TestUnmarshalXMLError(t *testing.T) {
+	for _, data := range []string{
+		`<record><rank>hello</rank></record>`,
+		`<record id="1.5"></record>`,
+		`<record><lenient>maybe</lenient></record>`,
+	} {
+		var rec xmlRecord
+		if err := xml.Unmarshal([]byte(data), &rec); err == nil {
+			t.Errorf("expected error unmarshaling %s", data)
+		}
+	}
+
+	var rec xmlRecord
+	err := xml.Unmarshal([]byte(`<record><lenient>yes</lenient></record>`), &rec)
+	maybePanic(err)
+	if !rec.Lenient.Valid || !rec.Lenient.Bool.Bool {
+		t.Errorf("lenient: got %+v, want true", rec.Lenient)
+	}
+}
+
+func assertXML(t *testing.T, data []byte, want, from string) {
+	t.Helper()
+	if string(data) != want {
+		t.Errorf("bad %s XML: %s ≠ %s\n", from, data, want)
+	}
+}